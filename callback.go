@@ -0,0 +1,359 @@
+package telegram
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const (
+	// MaxCallbackDataLength is the maximum length of InlineKeyboardButton.CallbackData in bytes.
+	MaxCallbackDataLength = 64
+
+	// callbackSignatureSize is the size of the truncated HMAC signing the callback data.
+	callbackSignatureSize = 8
+
+	// callbackStoredKeySize is the size of the random key of the callback data kept in a Storage.
+	callbackStoredKeySize = 12
+
+	// Headers of the callback data.
+	callbackInline byte = 0
+	callbackStored byte = 1
+)
+
+// ErrCallbackDataTampered is returned by CallbackCodec.Decode for the callback data not signed by the codec.
+var ErrCallbackDataTampered = errors.New("callback data is tampered")
+
+// CallbackCodec packs structs into signed callback data of inline keyboard buttons
+// and unpacks the data of callback queries, rejecting the data forged by users.
+//
+// The exported fields of a struct are encoded in order, so the struct must be the same on both ends.
+// Supported field types are bool, integers, strings, byte slices, and slices, arrays and structs of those.
+// The data is signed along with a kind telling the structs apart, so the data of one kind isn't decoded as another.
+//
+//	type vote struct {
+//		Action uint8
+//		PollID int64
+//	}
+//
+//	data, err := codec.Encode(ctx, "vote", &vote{Action: 1, PollID: 42})
+//	...
+//	var v vote
+//	err := codec.Decode(ctx, "vote", query.Data, &v)
+type CallbackCodec struct {
+	key     []byte
+	storage Storage
+	ttl     time.Duration
+}
+
+// CallbackOption defines an option for a CallbackCodec.
+type CallbackOption func(*CallbackCodec)
+
+// CallbackOptionStorage - keep the data not fitting into MaxCallbackDataLength in the storage for ttl,
+// sending only a random key of the stored data to the user.
+// A ttl of 0 keeps the data permanently, so a ttl should be set for buttons that may never be pressed.
+// Encode fails on the long data by default.
+func CallbackOptionStorage(storage Storage, ttl time.Duration) func(*CallbackCodec) {
+	return func(c *CallbackCodec) {
+		c.storage = storage
+		c.ttl = ttl
+	}
+}
+
+// NewCallbackCodec builds a CallbackCodec signing the data with the secret key.
+// The key must not be empty.
+func NewCallbackCodec(key []byte, options ...CallbackOption) (*CallbackCodec, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("callback data: key is empty")
+	}
+
+	c := &CallbackCodec{key: key}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// Encode returns the callback data of v of the kind, v being a struct or a pointer to a struct.
+func (c *CallbackCodec) Encode(ctx context.Context, kind string, v interface{}) (string, error) {
+	return c.encode(ctx, kind, v, MaxCallbackDataLength)
+}
+
+// encode returns the callback data of v of the kind, keeping it in the storage if it's longer than limit bytes.
+func (c *CallbackCodec) encode(ctx context.Context, kind string, v interface{}, limit int) (string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return "", fmt.Errorf("callback data: encode non-struct %T", v)
+	}
+
+	body, err := appendCallbackValue([]byte{callbackInline}, rv)
+	if err != nil {
+		return "", err
+	}
+
+	data := c.sign(kind, body)
+	if len(data) <= limit {
+		return data, nil
+	}
+
+	if c.storage == nil {
+		return "", fmt.Errorf("callback data is %d bytes, the limit is %d", len(data), limit)
+	}
+
+	key := make([]byte, callbackStoredKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	if err := c.storage.Set(ctx, callbackStorageKey(key), body[1:], c.ttl); err != nil {
+		return "", err
+	}
+
+	return c.sign(kind, append([]byte{callbackStored}, key...)), nil
+}
+
+// Decode verifies the callback data of the kind and stores the struct packed into it in the value pointed to by v.
+// Returns ErrCallbackDataTampered if the data isn't signed by the codec for the kind,
+// and ErrNotFound if the data was kept in the storage and has expired.
+func (c *CallbackCodec) Decode(ctx context.Context, kind, data string, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("callback data: decode into non-pointer %T", v)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil || len(raw) < 1+callbackSignatureSize {
+		return ErrCallbackDataTampered
+	}
+
+	body, signature := raw[:len(raw)-callbackSignatureSize], raw[len(raw)-callbackSignatureSize:]
+	if !hmac.Equal(signature, c.signature(kind, body)) {
+		return ErrCallbackDataTampered
+	}
+
+	switch body[0] {
+	case callbackInline:
+		body = body[1:]
+	case callbackStored:
+		if c.storage == nil {
+			return fmt.Errorf("callback data: no storage for the stored data")
+		}
+		body, err = c.storage.Get(ctx, callbackStorageKey(body[1:]))
+		if err != nil {
+			return err
+		}
+	default:
+		return ErrCallbackDataTampered
+	}
+
+	r := &callbackReader{b: body}
+	if err := r.readValue(rv.Elem()); err != nil {
+		return err
+	}
+	if len(r.b) > 0 {
+		return fmt.Errorf("callback data: %d trailing bytes", len(r.b))
+	}
+
+	return nil
+}
+
+// sign returns the body along with its signature for the kind, encoded with base64url.
+func (c *CallbackCodec) sign(kind string, body []byte) string {
+	return base64.RawURLEncoding.EncodeToString(append(body[:len(body):len(body)], c.signature(kind, body)...))
+}
+
+// signature returns the truncated HMAC-SHA256 of the length-prefixed kind followed by the body.
+func (c *CallbackCodec) signature(kind string, body []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(buf[:binary.PutUvarint(buf[:], uint64(len(kind)))])
+	mac.Write([]byte(kind))
+	mac.Write(body)
+
+	return mac.Sum(nil)[:callbackSignatureSize]
+}
+
+// callbackStorageKey returns the Storage key of the callback data kept under the random key.
+func callbackStorageKey(key []byte) string {
+	return "callback:" + hex.EncodeToString(key)
+}
+
+// appendCallbackValue appends the binary encoding of v to b.
+func appendCallbackValue(b []byte, v reflect.Value) ([]byte, error) {
+	var buf [binary.MaxVarintLen64]byte
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return append(b, buf[:binary.PutVarint(buf[:], v.Int())]...), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return append(b, buf[:binary.PutUvarint(buf[:], v.Uint())]...), nil
+	case reflect.String:
+		b = append(b, buf[:binary.PutUvarint(buf[:], uint64(v.Len()))]...)
+		return append(b, v.String()...), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b = append(b, buf[:binary.PutUvarint(buf[:], uint64(v.Len()))]...)
+			return append(b, v.Bytes()...), nil
+		}
+		b = append(b, buf[:binary.PutUvarint(buf[:], uint64(v.Len()))]...)
+		fallthrough
+	case reflect.Array:
+		var err error
+		for i := 0; i < v.Len(); i++ {
+			if b, err = appendCallbackValue(b, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case reflect.Struct:
+		var err error
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if b, err = appendCallbackValue(b, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("callback data: unsupported type %s", v.Type())
+}
+
+// callbackReader decodes values encoded by appendCallbackValue.
+type callbackReader struct {
+	b []byte
+}
+
+// errCallbackDataTruncated is returned by callbackReader when the data ends in the middle of a value.
+var errCallbackDataTruncated = errors.New("callback data: unexpected end of data")
+
+func (r *callbackReader) readUvarint() (uint64, error) {
+	x, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		return 0, errCallbackDataTruncated
+	}
+
+	r.b = r.b[n:]
+	return x, nil
+}
+
+func (r *callbackReader) readVarint() (int64, error) {
+	x, n := binary.Varint(r.b)
+	if n <= 0 {
+		return 0, errCallbackDataTruncated
+	}
+
+	r.b = r.b[n:]
+	return x, nil
+}
+
+func (r *callbackReader) readBytes() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.b)) {
+		return nil, errCallbackDataTruncated
+	}
+
+	b := r.b[:n]
+	r.b = r.b[n:]
+	return b, nil
+}
+
+// readValue decodes a value into v.
+func (r *callbackReader) readValue(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		if len(r.b) == 0 {
+			return errCallbackDataTruncated
+		}
+		v.SetBool(r.b[0] != 0)
+		r.b = r.b[1:]
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(x) {
+			return fmt.Errorf("callback data: %d overflows %s", x, v.Type())
+		}
+		v.SetInt(x)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, err := r.readUvarint()
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(x) {
+			return fmt.Errorf("callback data: %d overflows %s", x, v.Type())
+		}
+		v.SetUint(x)
+		return nil
+	case reflect.String:
+		b, err := r.readBytes()
+		if err != nil {
+			return err
+		}
+		v.SetString(string(b))
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := r.readBytes()
+			if err != nil {
+				return err
+			}
+			v.SetBytes(append([]byte(nil), b...))
+			return nil
+		}
+
+		n, err := r.readUvarint()
+		if err != nil {
+			return err
+		}
+		// Every element takes at least a byte.
+		if n > uint64(len(r.b)) {
+			return errCallbackDataTruncated
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(n), int(n)))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := r.readValue(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := r.readValue(v.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("callback data: unsupported type %s", v.Type())
+}
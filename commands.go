@@ -0,0 +1,65 @@
+package telegram
+
+import "context"
+
+// BotCommandSet is a list of the bot's commands for a scope of users and their language.
+type BotCommandSet struct {
+	// Scope of users for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope
+
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, commands are applied to all users from the scope, for whose language there are no dedicated commands.
+	LanguageCode string
+
+	// Commands of the bot.
+	// If empty, commands of the scope and language are deleted.
+	Commands []*BotCommand
+}
+
+// SyncMyCommands brings the bot's commands in line with sets.
+// The current commands of every set are requested first, and only the sets that differ are sent to Telegram.
+// Commands of scopes and languages missing from sets are left untouched,
+// pass a BotCommandSet without Commands to delete them.
+// Returns the sets that were changed.
+func (c *Client) SyncMyCommands(ctx context.Context, sets []*BotCommandSet) ([]*BotCommandSet, error) {
+	var changed []*BotCommandSet
+	for _, set := range sets {
+		current, err := c.GetMyCommands(ctx, &GetMyCommandsPayload{Scope: set.Scope, LanguageCode: set.LanguageCode})
+		if err != nil {
+			return changed, err
+		}
+
+		if equalBotCommands(current, set.Commands) {
+			continue
+		}
+
+		if len(set.Commands) == 0 {
+			_, err = c.DeleteMyCommands(ctx, &DeleteMyCommandsPayload{Scope: set.Scope, LanguageCode: set.LanguageCode})
+		} else {
+			_, err = c.SetMyCommands(ctx, &SetMyCommandsPayload{Commands: set.Commands, Scope: set.Scope, LanguageCode: set.LanguageCode})
+		}
+		if err != nil {
+			return changed, err
+		}
+
+		changed = append(changed, set)
+	}
+
+	return changed, nil
+}
+
+// equalBotCommands reports whether a and b are the same commands in the same order.
+func equalBotCommands(a, b []*BotCommand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+
+	return true
+}
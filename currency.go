@@ -0,0 +1,79 @@
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// currencyExponents are the exponents of the supported currencies without a fractional part.
+// Every other currency has 2 digits past the decimal point.
+// https://core.telegram.org/bots/payments/currencies.json
+var currencyExponents = map[string]int{
+	"CLP": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"UGX": 0,
+	"VND": 0,
+	"XTR": 0,
+}
+
+// CurrencyExponent returns the number of digits past the decimal point of the three-letter ISO 4217 currency,
+// that is the power of ten of the smallest units of the currency in a unit.
+func CurrencyExponent(currency string) int {
+	if exp, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+
+	return 2
+}
+
+// FormatAmount formats the amount in the smallest units of the currency as a decimal number,
+// e.g. 145 USD is formatted as "1.45".
+func FormatAmount(amount int64, currency string) string {
+	exp := CurrencyExponent(currency)
+
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	s := strconv.FormatInt(amount, 10)
+	if exp == 0 {
+		return sign + s
+	}
+
+	if len(s) <= exp {
+		s = strings.Repeat("0", exp-len(s)+1) + s
+	}
+
+	return sign + s[:len(s)-exp] + "." + s[len(s)-exp:]
+}
+
+// ParseAmount parses the decimal number in the currency to an amount in its smallest units,
+// e.g. "1.45" USD is parsed as 145.
+// The number must not have more digits past the decimal point than the currency.
+func ParseAmount(s, currency string) (int64, error) {
+	exp := CurrencyExponent(currency)
+
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+
+	if len(fraction) > exp {
+		return 0, fmt.Errorf("amount %q has more than %d digits past the decimal point for %s", s, exp, currency)
+	}
+	if strings.ContainsAny(fraction, "+-") {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	amount, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", exp-len(fraction)), 10, 64)
+	if err != nil || whole == "" || whole == "-" || whole == "+" {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return amount, nil
+}
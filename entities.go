@@ -0,0 +1,450 @@
+package telegram
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// TextBuilder builds a message text along with its entities,
+// so the text can be sent without a parse mode and needs no escaping.
+//
+//	b := telegram.NewTextBuilder().Bold("Hello").Text(", ").Mention(user).Text("!")
+//	client.SendMessage(ctx, &telegram.SendMessagePayload{ChatID: chatID, Text: b.String(), Entities: b.Entities()})
+type TextBuilder struct {
+	text     strings.Builder
+	length   int
+	entities []*MessageEntity
+}
+
+// NewTextBuilder builds an empty TextBuilder.
+func NewTextBuilder() *TextBuilder {
+	return &TextBuilder{}
+}
+
+// Text appends a plain text.
+func (b *TextBuilder) Text(s string) *TextBuilder {
+	b.text.WriteString(s)
+	b.length += utf16Len(s)
+	return b
+}
+
+// Entity appends a text with the entity.
+// Offset and Length of the entity are set by the builder.
+func (b *TextBuilder) Entity(entity *MessageEntity, s string) *TextBuilder {
+	length := utf16Len(s)
+	if length > 0 {
+		entity.Offset = b.length
+		entity.Length = length
+		b.entities = append(b.entities, entity)
+	}
+
+	b.text.WriteString(s)
+	b.length += length
+	return b
+}
+
+// Bold appends a bold text.
+func (b *TextBuilder) Bold(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeBold}, s)
+}
+
+// Italic appends an italic text.
+func (b *TextBuilder) Italic(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeItalic}, s)
+}
+
+// Underline appends an underlined text.
+func (b *TextBuilder) Underline(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeUnderline}, s)
+}
+
+// Strikethrough appends a strikethrough text.
+func (b *TextBuilder) Strikethrough(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeStrikethrough}, s)
+}
+
+// Spoiler appends a text hidden under a spoiler.
+func (b *TextBuilder) Spoiler(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeSpoiler}, s)
+}
+
+// Code appends a monowidth string.
+func (b *TextBuilder) Code(s string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeCode}, s)
+}
+
+// Pre appends a monowidth block of code in the programming language.
+// The language can be empty.
+func (b *TextBuilder) Pre(code, language string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypePre, Language: language}, code)
+}
+
+// Link appends a text opening the url when tapped.
+func (b *TextBuilder) Link(s, url string) *TextBuilder {
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeTextLink, URL: url}, s)
+}
+
+// Mention appends the name of the user mentioning them, which works for users without usernames.
+func (b *TextBuilder) Mention(user *User) *TextBuilder {
+	name := user.FirstName
+	if user.LastName != "" {
+		name += " " + user.LastName
+	}
+
+	return b.Entity(&MessageEntity{Type: MessageEntityTypeTextMention, User: user}, name)
+}
+
+// String returns the text built.
+func (b *TextBuilder) String() string {
+	return b.text.String()
+}
+
+// Entities returns the entities of the text built.
+func (b *TextBuilder) Entities() []*MessageEntity {
+	return b.entities
+}
+
+// utf16Len returns the length of s in UTF-16 code units, in which Telegram measures texts.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+
+	return n
+}
+
+// EntityText returns the part of the text the entity refers to.
+func EntityText(text string, entity *MessageEntity) string {
+	units := utf16.Encode([]rune(text))
+	start, end := entity.Offset, entity.Offset+entity.Length
+	if start < 0 || end > len(units) || start > end {
+		return ""
+	}
+
+	return string(utf16.Decode(units[start:end]))
+}
+
+// content returns the text of the message along with its entities,
+// or the caption of the message along with its entities if the message has no text.
+func (m *Message) content() (string, []*MessageEntity) {
+	if m.Text != "" {
+		return m.Text, m.Entities
+	}
+
+	return m.Caption, m.CaptionEntities
+}
+
+// ExtractEntities returns the parts of the message text or caption referred to by the entities of the type.
+func (m *Message) ExtractEntities(t MessageEntityType) []string {
+	text, entities := m.content()
+
+	var parts []string
+	for _, entity := range entities {
+		if entity.Type == t {
+			parts = append(parts, EntityText(text, entity))
+		}
+	}
+
+	return parts
+}
+
+// URLs returns the URLs in the message text or caption, both written out and hidden behind text links.
+func (m *Message) URLs() []string {
+	text, entities := m.content()
+
+	var urls []string
+	for _, entity := range entities {
+		switch entity.Type {
+		case MessageEntityTypeURL:
+			urls = append(urls, EntityText(text, entity))
+		case MessageEntityTypeTextLink:
+			urls = append(urls, entity.URL)
+		}
+	}
+
+	return urls
+}
+
+// Mentions returns the @usernames mentioned in the message text or caption.
+func (m *Message) Mentions() []string {
+	return m.ExtractEntities(MessageEntityTypeMention)
+}
+
+// HashTags returns the #hashtags in the message text or caption.
+func (m *Message) HashTags() []string {
+	return m.ExtractEntities(MessageEntityTypeHashTag)
+}
+
+// CashTags returns the $USD cashtags in the message text or caption.
+func (m *Message) CashTags() []string {
+	return m.ExtractEntities(MessageEntityTypeCashTag)
+}
+
+// BotCommands returns the /start@jobs_bot bot commands in the message text or caption.
+func (m *Message) BotCommands() []string {
+	return m.ExtractEntities(MessageEntityTypeBotCommand)
+}
+
+// HTML returns the message text or caption formatted with ParseModeHTML.
+func (m *Message) HTML() string {
+	return RenderHTML(m.content())
+}
+
+// MarkdownV2 returns the message text or caption formatted with ParseModeMarkdownV2.
+func (m *Message) MarkdownV2() string {
+	return RenderMarkdownV2(m.content())
+}
+
+// RenderHTML returns the text with the formatting entities converted to ParseModeHTML markup.
+func RenderHTML(text string, entities []*MessageEntity) string {
+	return render(text, entities, htmlRenderer{})
+}
+
+// RenderMarkdownV2 returns the text with the formatting entities converted to ParseModeMarkdownV2 markup.
+func RenderMarkdownV2(text string, entities []*MessageEntity) string {
+	return render(text, entities, markdownV2Renderer{})
+}
+
+// renderer converts entities to the markup of a parse mode.
+type renderer interface {
+	// open returns the markup starting the entity.
+	open(entity *MessageEntity) string
+
+	// close returns the markup ending the entity.
+	close(entity *MessageEntity) string
+
+	// escape escapes a text inside the code entity if code is True.
+	escape(s string, code bool) string
+}
+
+// render writes the text with the markup of the entities.
+// Entities crossing each other are closed and reopened to keep the markup well-nested.
+func render(text string, entities []*MessageEntity, r renderer) string {
+	units := utf16.Encode([]rune(text))
+
+	sorted := make([]*MessageEntity, 0, len(entities))
+	for _, entity := range entities {
+		if entity.Length > 0 && entity.Offset >= 0 && entity.Offset+entity.Length <= len(units) {
+			sorted = append(sorted, entity)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Offset != sorted[j].Offset {
+			return sorted[i].Offset < sorted[j].Offset
+		}
+		return sorted[i].Length > sorted[j].Length
+	})
+
+	var (
+		out   strings.Builder
+		stack []*MessageEntity
+		pos   int
+	)
+
+	write := func(markup string) {
+		// "___" is ambiguous in MarkdownV2, the ignored \r separates italic and underline markers.
+		if strings.HasPrefix(markup, "_") && strings.HasSuffix(out.String(), "_") {
+			out.WriteByte('\r')
+		}
+		out.WriteString(markup)
+	}
+
+	inCode := func() bool {
+		for _, entity := range stack {
+			if entity.Type == MessageEntityTypeCode || entity.Type == MessageEntityTypePre {
+				return true
+			}
+		}
+		return false
+	}
+
+	// advance writes the text up to target, closing the entities ending on the way.
+	advance := func(target int) {
+		for {
+			end := target
+			for _, entity := range stack {
+				if e := entity.Offset + entity.Length; e < end {
+					end = e
+				}
+			}
+
+			if pos < end {
+				out.WriteString(r.escape(string(utf16.Decode(units[pos:end])), inCode()))
+				pos = end
+			}
+
+			k := -1
+			for i, entity := range stack {
+				if entity.Offset+entity.Length == pos {
+					k = i
+					break
+				}
+			}
+			if k < 0 {
+				return
+			}
+
+			var reopen []*MessageEntity
+			for i := len(stack) - 1; i >= k; i-- {
+				write(r.close(stack[i]))
+				if stack[i].Offset+stack[i].Length != pos {
+					reopen = append([]*MessageEntity{stack[i]}, reopen...)
+				}
+			}
+
+			stack = stack[:k]
+			for _, entity := range reopen {
+				write(r.open(entity))
+				stack = append(stack, entity)
+			}
+		}
+	}
+
+	for _, entity := range sorted {
+		advance(entity.Offset)
+		write(r.open(entity))
+		stack = append(stack, entity)
+	}
+	advance(len(units))
+
+	return out.String()
+}
+
+// htmlRenderer renders entities as ParseModeHTML markup.
+type htmlRenderer struct{}
+
+func (htmlRenderer) open(entity *MessageEntity) string {
+	switch entity.Type {
+	case MessageEntityTypeBold:
+		return "<b>"
+	case MessageEntityTypeItalic:
+		return "<i>"
+	case MessageEntityTypeUnderline:
+		return "<u>"
+	case MessageEntityTypeStrikethrough:
+		return "<s>"
+	case MessageEntityTypeSpoiler:
+		return "<tg-spoiler>"
+	case MessageEntityTypeCode:
+		return "<code>"
+	case MessageEntityTypePre:
+		if entity.Language != "" {
+			return `<pre><code class="language-` + EscapeHTML(entity.Language) + `">`
+		}
+		return "<pre>"
+	case MessageEntityTypeTextLink:
+		return `<a href="` + EscapeHTML(entity.URL) + `">`
+	case MessageEntityTypeTextMention:
+		if entity.User != nil {
+			return `<a href="tg://user?id=` + strconv.FormatInt(entity.User.ID, 10) + `">`
+		}
+	}
+
+	return ""
+}
+
+func (htmlRenderer) close(entity *MessageEntity) string {
+	switch entity.Type {
+	case MessageEntityTypeBold:
+		return "</b>"
+	case MessageEntityTypeItalic:
+		return "</i>"
+	case MessageEntityTypeUnderline:
+		return "</u>"
+	case MessageEntityTypeStrikethrough:
+		return "</s>"
+	case MessageEntityTypeSpoiler:
+		return "</tg-spoiler>"
+	case MessageEntityTypeCode:
+		return "</code>"
+	case MessageEntityTypePre:
+		if entity.Language != "" {
+			return "</code></pre>"
+		}
+		return "</pre>"
+	case MessageEntityTypeTextLink:
+		return "</a>"
+	case MessageEntityTypeTextMention:
+		if entity.User != nil {
+			return "</a>"
+		}
+	}
+
+	return ""
+}
+
+func (htmlRenderer) escape(s string, _ bool) string {
+	return EscapeHTML(s)
+}
+
+// markdownV2Renderer renders entities as ParseModeMarkdownV2 markup.
+type markdownV2Renderer struct{}
+
+func (markdownV2Renderer) open(entity *MessageEntity) string {
+	switch entity.Type {
+	case MessageEntityTypeBold:
+		return "*"
+	case MessageEntityTypeItalic:
+		return "_"
+	case MessageEntityTypeUnderline:
+		return "__"
+	case MessageEntityTypeStrikethrough:
+		return "~"
+	case MessageEntityTypeSpoiler:
+		return "||"
+	case MessageEntityTypeCode:
+		return "`"
+	case MessageEntityTypePre:
+		return "```" + entity.Language + "\n"
+	case MessageEntityTypeTextLink:
+		return "["
+	case MessageEntityTypeTextMention:
+		if entity.User != nil {
+			return "["
+		}
+	}
+
+	return ""
+}
+
+func (markdownV2Renderer) close(entity *MessageEntity) string {
+	switch entity.Type {
+	case MessageEntityTypeBold:
+		return "*"
+	case MessageEntityTypeItalic:
+		return "_"
+	case MessageEntityTypeUnderline:
+		return "__"
+	case MessageEntityTypeStrikethrough:
+		return "~"
+	case MessageEntityTypeSpoiler:
+		return "||"
+	case MessageEntityTypeCode:
+		return "`"
+	case MessageEntityTypePre:
+		return "```"
+	case MessageEntityTypeTextLink:
+		return "](" + EscapeMarkdownV2URL(entity.URL) + ")"
+	case MessageEntityTypeTextMention:
+		if entity.User != nil {
+			return "](tg://user?id=" + strconv.FormatInt(entity.User.ID, 10) + ")"
+		}
+	}
+
+	return ""
+}
+
+func (markdownV2Renderer) escape(s string, code bool) string {
+	if code {
+		return EscapeMarkdownV2Code(s)
+	}
+
+	return EscapeMarkdownV2(s)
+}
@@ -0,0 +1,108 @@
+package telegram
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	markdownV2Escaper     = newEscaper("_*[]()~`>#+-=|{}.!\\")
+	markdownV2CodeEscaper = newEscaper("`\\")
+	markdownV2URLEscaper  = newEscaper(")\\")
+	markdownEscaper       = newEscaper("_*`[")
+
+	htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// newEscaper returns a replacer prefixing every one of chars with a backslash.
+func newEscaper(chars string) *strings.Replacer {
+	pairs := make([]string, 0, 2*len(chars))
+	for _, c := range chars {
+		pairs = append(pairs, string(c), `\`+string(c))
+	}
+
+	return strings.NewReplacer(pairs...)
+}
+
+// EscapeMarkdownV2 escapes s to be used as a text in a message with ParseModeMarkdownV2.
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Escaper.Replace(s)
+}
+
+// EscapeMarkdownV2Code escapes s to be used inside a code or pre entity in a message with ParseModeMarkdownV2.
+func EscapeMarkdownV2Code(s string) string {
+	return markdownV2CodeEscaper.Replace(s)
+}
+
+// EscapeMarkdownV2URL escapes s to be used inside (...) part of an inline link in a message with ParseModeMarkdownV2.
+func EscapeMarkdownV2URL(s string) string {
+	return markdownV2URLEscaper.Replace(s)
+}
+
+// EscapeMarkdown escapes s to be used as a text in a message with the legacy ParseModeMarkdown.
+func EscapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// EscapeHTML escapes s to be used as a text or an attribute value in a message with ParseModeHTML.
+func EscapeHTML(s string) string {
+	return htmlEscaper.Replace(s)
+}
+
+// Escape escapes s to be used as a text in a message with the parse mode.
+// The text is returned as is for an unknown parse mode.
+func Escape(mode ParseMode, s string) string {
+	switch mode {
+	case ParseModeMarkdownV2:
+		return EscapeMarkdownV2(s)
+	case ParseModeMarkdown:
+		return EscapeMarkdown(s)
+	case ParseModeHTML:
+		return EscapeHTML(s)
+	}
+
+	return s
+}
+
+// Format formats according to a format specifier like fmt.Sprintf,
+// escaping every formatted argument for the parse mode.
+// The format itself is not escaped, so it can contain markup and must already be valid for the parse mode:
+//
+//	telegram.Format(telegram.ParseModeHTML, "<b>%s</b> has %d points", user.FirstName, points)
+func Format(mode ParseMode, format string, args ...interface{}) string {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = escapedArg{mode: mode, arg: arg}
+	}
+
+	return fmt.Sprintf(format, escaped...)
+}
+
+// escapedArg is an argument of Format, escaped after it's formatted.
+type escapedArg struct {
+	mode ParseMode
+	arg  interface{}
+}
+
+// Format implements fmt.Formatter.
+func (a escapedArg) Format(f fmt.State, verb rune) {
+	var directive strings.Builder
+	directive.WriteByte('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive.WriteRune(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		directive.WriteString(strconv.Itoa(width))
+	}
+	if precision, ok := f.Precision(); ok {
+		directive.WriteByte('.')
+		directive.WriteString(strconv.Itoa(precision))
+	}
+	directive.WriteRune(verb)
+
+	_, _ = io.WriteString(f, Escape(a.mode, fmt.Sprintf(directive.String(), a.arg)))
+}
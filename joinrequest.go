@@ -0,0 +1,399 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// joinRequestCallbackPrefix is a prefix of the callback data of JoinChallenge buttons.
+const joinRequestCallbackPrefix = "jr:"
+
+// JoinChallenge is a question sent to the user who sent a ChatJoinRequest.
+// The join request is approved if the user chooses the correct option, and declined otherwise.
+type JoinChallenge struct {
+	// Text of the message with the challenge.
+	Text string
+
+	// Options the user can choose from, one inline keyboard button per option.
+	Options []string
+
+	// Answer is a 0-based index of the correct option.
+	Answer int
+}
+
+// QuizChallenge returns a JoinChallenge asking the question with the correct answer at index answer.
+func QuizChallenge(question string, options []string, answer int) *JoinChallenge {
+	return &JoinChallenge{Text: question, Options: options, Answer: answer}
+}
+
+// CaptchaChallenge returns a JoinChallenge asking the user to press the button with the given emoji.
+func CaptchaChallenge() *JoinChallenge {
+	emoji := []string{"🍎", "🚗", "🐶", "⚽", "🌵", "🎸"}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.Shuffle(len(emoji), func(i, j int) { emoji[i], emoji[j] = emoji[j], emoji[i] })
+
+	options := emoji[:4]
+	answer := r.Intn(len(options))
+
+	return &JoinChallenge{
+		Text:    "Press " + options[answer] + " to join the chat.",
+		Options: options,
+		Answer:  answer,
+	}
+}
+
+// RulesChallenge returns a JoinChallenge asking the user to accept the rules of the chat.
+func RulesChallenge(rules string) *JoinChallenge {
+	return &JoinChallenge{Text: rules, Options: []string{"Accept", "Decline"}, Answer: 0}
+}
+
+// PendingJoinRequest is a ChatJoinRequest waiting for the user to answer a JoinChallenge.
+type PendingJoinRequest struct {
+	// ChatID is an identifier of the chat the user wants to join.
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is an identifier of the user who sent the join request.
+	UserID int64 `json:"user_id"`
+
+	// MessageID is an identifier of the message with the challenge in the private chat with the user.
+	MessageID int64 `json:"message_id"`
+
+	// Answer is a 0-based index of the correct option.
+	Answer int `json:"answer"`
+
+	// Deadline is a point in time after which the join request is declined,
+	// or the decision is retried if the join request has been decided.
+	Deadline time.Time `json:"deadline"`
+
+	// Decided is True, if the join request has been decided but failed to be approved or declined.
+	// The decision is retried instead of accepting another answer.
+	//
+	// Optional.
+	Decided bool `json:"decided,omitempty"`
+
+	// Approve is True, if the decided join request is to be approved, and False, if it's to be declined.
+	//
+	// Optional.
+	Approve bool `json:"approve,omitempty"`
+}
+
+// JoinRequestStore persists pending join requests.
+type JoinRequestStore interface {
+	// Save stores the pending join request, replacing the one for the same chat and user.
+	Save(ctx context.Context, req *PendingJoinRequest) error
+
+	// Take atomically removes and returns the pending join request of the user to the chat,
+	// or returns nil if there is none, so that only one caller gets the request.
+	Take(ctx context.Context, chatID, userID int64) (*PendingJoinRequest, error)
+
+	// Expired returns the pending join requests with a deadline before now.
+	Expired(ctx context.Context, now time.Time) ([]*PendingJoinRequest, error)
+}
+
+// MemoryJoinRequestStore is a JoinRequestStore keeping pending join requests in memory.
+type MemoryJoinRequestStore struct {
+	mu       sync.Mutex
+	requests map[[2]int64]*PendingJoinRequest
+}
+
+// NewMemoryJoinRequestStore builds an empty MemoryJoinRequestStore.
+func NewMemoryJoinRequestStore() *MemoryJoinRequestStore {
+	return &MemoryJoinRequestStore{requests: make(map[[2]int64]*PendingJoinRequest)}
+}
+
+// Save stores the pending join request.
+func (s *MemoryJoinRequestStore) Save(_ context.Context, req *PendingJoinRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[[2]int64{req.ChatID, req.UserID}] = req
+	return nil
+}
+
+// Take removes and returns the pending join request, or nil if there is none.
+func (s *MemoryJoinRequestStore) Take(_ context.Context, chatID, userID int64) (*PendingJoinRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := [2]int64{chatID, userID}
+	req := s.requests[key]
+	delete(s.requests, key)
+
+	return req, nil
+}
+
+// Expired returns the pending join requests with a deadline before now.
+func (s *MemoryJoinRequestStore) Expired(_ context.Context, now time.Time) ([]*PendingJoinRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired []*PendingJoinRequest
+	for _, req := range s.requests {
+		if req.Deadline.Before(now) {
+			expired = append(expired, req)
+		}
+	}
+
+	return expired, nil
+}
+
+// JoinRequestVetter approves or declines join requests depending on the answer to a JoinChallenge
+// sent to the user in a private chat.
+//
+// Pass every Update to HandleUpdate and call Run to decline the join requests of users who never answer.
+type JoinRequestVetter struct {
+	client    *Client
+	challenge func(req *ChatJoinRequest) *JoinChallenge
+	store     JoinRequestStore
+	timeout   time.Duration
+	logger    *log.Logger
+}
+
+// JoinRequestOption defines an option for a JoinRequestVetter.
+type JoinRequestOption func(*JoinRequestVetter)
+
+// JoinRequestOptionStore - provide a custom store of pending join requests.
+// Defaults to a MemoryJoinRequestStore.
+func JoinRequestOptionStore(store JoinRequestStore) func(*JoinRequestVetter) {
+	return func(v *JoinRequestVetter) { v.store = store }
+}
+
+// JoinRequestOptionTimeout set the time the user has to answer the challenge.
+// Defaults to 5 minutes.
+func JoinRequestOptionTimeout(timeout time.Duration) func(*JoinRequestVetter) {
+	return func(v *JoinRequestVetter) { v.timeout = timeout }
+}
+
+// JoinRequestOptionLogger set the logger of the decisions made.
+// Decisions are not logged by default.
+func JoinRequestOptionLogger(logger *log.Logger) func(*JoinRequestVetter) {
+	return func(v *JoinRequestVetter) { v.logger = logger }
+}
+
+// NewJoinRequestVetter builds a JoinRequestVetter sending the challenges returned by challenge.
+func NewJoinRequestVetter(client *Client, challenge func(req *ChatJoinRequest) *JoinChallenge, options ...JoinRequestOption) *JoinRequestVetter {
+	v := &JoinRequestVetter{
+		client:    client,
+		challenge: challenge,
+		store:     NewMemoryJoinRequestStore(),
+		timeout:   5 * time.Minute,
+	}
+
+	for _, opt := range options {
+		opt(v)
+	}
+
+	return v
+}
+
+// HandleUpdate handles the ChatJoinRequest and the CallbackQuery with an answer to a challenge.
+// Reports whether the update was handled.
+func (v *JoinRequestVetter) HandleUpdate(ctx context.Context, update *Update) (bool, error) {
+	switch {
+	case update.ChatJoinRequest != nil:
+		return true, v.HandleJoinRequest(ctx, update.ChatJoinRequest)
+	case update.CallbackQuery != nil:
+		return v.HandleCallbackQuery(ctx, update.CallbackQuery)
+	}
+
+	return false, nil
+}
+
+// HandleJoinRequest sends a challenge to the user who sent the join request and waits for the answer.
+func (v *JoinRequestVetter) HandleJoinRequest(ctx context.Context, req *ChatJoinRequest) error {
+	if req.Chat == nil || req.From == nil {
+		return fmt.Errorf("join request: chat or user is missing")
+	}
+
+	challenge := v.challenge(req)
+	if challenge == nil {
+		return fmt.Errorf("join request: chat %d, user %d: no challenge", req.Chat.ID, req.From.ID)
+	}
+
+	keyboard := make([][]*InlineKeyboardButton, 0, len(challenge.Options))
+	for i, option := range challenge.Options {
+		keyboard = append(keyboard, []*InlineKeyboardButton{{
+			Text:         option,
+			CallbackData: joinRequestCallbackPrefix + strconv.FormatInt(req.Chat.ID, 10) + ":" + strconv.Itoa(i),
+		}})
+	}
+
+	message, err := v.client.SendMessage(ctx, &SendMessagePayload{
+		ChatID:      req.From.ID,
+		Text:        challenge.Text,
+		ReplyMarkup: &InlineKeyboardMarkup{InlineKeyboard: keyboard},
+	})
+	if err != nil {
+		return err
+	}
+
+	return v.store.Save(ctx, &PendingJoinRequest{
+		ChatID:    req.Chat.ID,
+		UserID:    req.From.ID,
+		MessageID: message.MessageID,
+		Answer:    challenge.Answer,
+		Deadline:  time.Now().Add(v.timeout),
+	})
+}
+
+// HandleCallbackQuery approves or declines the join request according to the answer in the callback query.
+// Reports whether the callback query was an answer to a challenge.
+func (v *JoinRequestVetter) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) (bool, error) {
+	if !strings.HasPrefix(query.Data, joinRequestCallbackPrefix) {
+		return false, nil
+	}
+
+	var chatID int64
+	var option int
+	if _, err := fmt.Sscanf(strings.TrimPrefix(query.Data, joinRequestCallbackPrefix), "%d:%d", &chatID, &option); err != nil {
+		return true, err
+	}
+
+	if _, err := v.client.AnswerCallbackQuery(ctx, &AnswerCallbackQueryPayload{CallbackQueryID: query.ID}); err != nil {
+		return true, err
+	}
+
+	req, err := v.store.Take(ctx, chatID, query.From.ID)
+	if err != nil {
+		return true, err
+	}
+
+	// The join request has already been decided.
+	if req == nil {
+		return true, nil
+	}
+
+	if req.Decided {
+		return true, v.decide(ctx, req, req.Approve, "retried")
+	}
+
+	if time.Now().After(req.Deadline) {
+		return true, v.decide(ctx, req, false, "timed out")
+	}
+
+	return true, v.decide(ctx, req, option == req.Answer, "answered")
+}
+
+// DeclineExpired declines the join requests of users who haven't answered the challenge in time,
+// and retries the decisions of the join requests which failed to be approved or declined.
+// A join request failing to be decided is logged and left pending to be retried,
+// while the rest of the join requests are still decided.
+func (v *JoinRequestVetter) DeclineExpired(ctx context.Context) error {
+	now := time.Now()
+
+	expired, err := v.store.Expired(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, pending := range expired {
+		req, err := v.store.Take(ctx, pending.ChatID, pending.UserID)
+		if err != nil {
+			v.logf("join request: chat %d, user %d: %v", pending.ChatID, pending.UserID, err)
+			failed++
+			continue
+		}
+
+		// The join request has been decided meanwhile.
+		if req == nil {
+			continue
+		}
+
+		// The user has sent a new join request meanwhile.
+		if !req.Deadline.Before(now) {
+			if err := v.store.Save(ctx, req); err != nil {
+				v.logf("join request: chat %d, user %d: %v", req.ChatID, req.UserID, err)
+				failed++
+			}
+			continue
+		}
+
+		approve, reason := false, "timed out"
+		if req.Decided {
+			approve, reason = req.Approve, "retried"
+		}
+
+		if err := v.decide(ctx, req, approve, reason); err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("join request: failed to decide %d of %d expired join requests", failed, len(expired))
+	}
+
+	return nil
+}
+
+// Run calls DeclineExpired every interval until ctx is done.
+func (v *JoinRequestVetter) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := v.DeclineExpired(ctx); err != nil {
+				v.logf("join request: %v", err)
+			}
+		}
+	}
+}
+
+// decide approves or declines the pending join request taken from the store and removes the challenge keyboard.
+// If the join request fails to be approved or declined, it's put back to the store with the decision
+// to be retried by DeclineExpired, unless the error is permanent.
+func (v *JoinRequestVetter) decide(ctx context.Context, req *PendingJoinRequest, approve bool, reason string) error {
+	var err error
+	if approve {
+		_, err = v.client.ApproveChatJoinRequest(ctx, &ApproveChatJoinRequestPayload{ChatID: req.ChatID, UserID: req.UserID})
+	} else {
+		_, err = v.client.DeclineChatJoinRequest(ctx, &DeclineChatJoinRequestPayload{ChatID: req.ChatID, UserID: req.UserID})
+	}
+
+	decision := "declined"
+	if approve {
+		decision = "approved"
+	}
+
+	if err != nil {
+		v.logf("join request: chat %d, user %d: %s, not %s: %v", req.ChatID, req.UserID, reason, decision, err)
+		if isPermanentError(err) {
+			return err
+		}
+
+		req.Decided, req.Approve, req.Deadline = true, approve, time.Now()
+		if saveErr := v.store.Save(ctx, req); saveErr != nil {
+			v.logf("join request: chat %d, user %d: %v", req.ChatID, req.UserID, saveErr)
+		}
+		return err
+	}
+	v.logf("join request: chat %d, user %d: %s, %s", req.ChatID, req.UserID, reason, decision)
+
+	_, err = v.client.EditMessageReplyMarkup(ctx, &EditMessageReplyMarkupPayload{ChatID: req.UserID, MessageID: req.MessageID})
+	return err
+}
+
+// isPermanentError reports whether the request failed with an error of the Bot API which retrying won't fix,
+// like a join request already decided by an administrator.
+func isPermanentError(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code >= 400 && apiErr.Code < 500 && apiErr.Code != 429
+}
+
+func (v *JoinRequestVetter) logf(format string, args ...interface{}) {
+	if v.logger != nil {
+		v.logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,308 @@
+package telegram
+
+import "fmt"
+
+// Button builds the buttons of inline and reply keyboards:
+//
+//	keyboard, err := telegram.NewInlineKeyboard().
+//		Row(telegram.Button.Callback("Yes", "y"), telegram.Button.Callback("No", "n")).
+//		Row(telegram.Button.URL("Docs", "https://core.telegram.org/bots/api")).
+//		Build()
+var Button buttonFactory
+
+// buttonFactory is the type of Button.
+type buttonFactory struct{}
+
+// Callback returns an inline keyboard button sending a callback query with the data when pressed.
+func (buttonFactory) Callback(text, data string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+// URL returns an inline keyboard button opening the url when pressed.
+func (buttonFactory) URL(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, URL: url}
+}
+
+// LoginURL returns an inline keyboard button authorizing the user with the Telegram Login Widget when pressed.
+func (buttonFactory) LoginURL(text string, loginURL *LoginURL) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, LoginURL: loginURL}
+}
+
+// InlineWebApp returns an inline keyboard button opening the Web App at the url when pressed.
+func (buttonFactory) InlineWebApp(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// SwitchInlineQuery returns an inline keyboard button prompting the user to select a chat
+// and inserting the bot's username and the query in the input field of the chat when pressed.
+// The query can be empty, in which case just the bot's username is inserted.
+func (buttonFactory) SwitchInlineQuery(text, query string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQuery: &query}
+}
+
+// SwitchInlineQueryCurrentChat returns an inline keyboard button inserting the bot's username
+// and the query in the input field of the current chat when pressed.
+// The query can be empty, in which case just the bot's username is inserted.
+func (buttonFactory) SwitchInlineQueryCurrentChat(text, query string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQueryCurrentChat: &query}
+}
+
+// Game returns an inline keyboard button launching the game of the message when pressed.
+// It must be the first button in the first row.
+func (buttonFactory) Game(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// Pay returns an inline keyboard button paying the invoice of the message when pressed.
+// It must be the first button in the first row.
+func (buttonFactory) Pay(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, Pay: true}
+}
+
+// Text returns a reply keyboard button sending its text as a message when pressed.
+func (buttonFactory) Text(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text}
+}
+
+// RequestContact returns a reply keyboard button sending the user's phone number as a contact when pressed.
+func (buttonFactory) RequestContact(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestContact: true}
+}
+
+// RequestLocation returns a reply keyboard button sending the user's current location when pressed.
+func (buttonFactory) RequestLocation(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestLocation: true}
+}
+
+// RequestPoll returns a reply keyboard button asking the user to create a poll of the type when pressed.
+// If the type is empty, the user can create a poll of any type.
+func (buttonFactory) RequestPoll(text string, pollType PollType) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestPoll: &KeyboardButtonPollType{Type: pollType}}
+}
+
+// WebApp returns a reply keyboard button opening the Web App at the url when pressed.
+func (buttonFactory) WebApp(text, url string) *KeyboardButton {
+	return &KeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// InlineKeyboardBuilder builds an InlineKeyboardMarkup row by row.
+type InlineKeyboardBuilder struct {
+	rows [][]*InlineKeyboardButton
+	err  error
+}
+
+// NewInlineKeyboard builds an empty InlineKeyboardBuilder.
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Row appends a row of the buttons.
+func (b *InlineKeyboardBuilder) Row(buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	b.rows = append(b.rows, buttons)
+	return b
+}
+
+// Columns appends the buttons laid out in rows of n buttons, the last row holding the rest.
+// Build fails if n is not positive.
+func (b *InlineKeyboardBuilder) Columns(n int, buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	if n <= 0 && b.err == nil {
+		b.err = fmt.Errorf("keyboard columns must be positive, got %d", n)
+	}
+
+	for n > 0 && len(buttons) > 0 {
+		if n > len(buttons) {
+			n = len(buttons)
+		}
+		b.rows = append(b.rows, buttons[:n:n])
+		buttons = buttons[n:]
+	}
+
+	return b
+}
+
+// Build validates the buttons and returns the keyboard.
+func (b *InlineKeyboardBuilder) Build() (*InlineKeyboardMarkup, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if err := validateRows(len(b.rows), func(i int) int { return len(b.rows[i]) }); err != nil {
+		return nil, err
+	}
+
+	for i, row := range b.rows {
+		for j, button := range row {
+			if err := validateInlineKeyboardButton(button, i == 0 && j == 0); err != nil {
+				return nil, fmt.Errorf("keyboard row %d button %d: %w", i, j, err)
+			}
+		}
+	}
+
+	return &InlineKeyboardMarkup{InlineKeyboard: b.rows}, nil
+}
+
+// validateInlineKeyboardButton checks the button has a text and exactly one action.
+// A game or pay button is allowed only if first is True.
+func validateInlineKeyboardButton(button *InlineKeyboardButton, first bool) error {
+	if button == nil {
+		return fmt.Errorf("button is nil")
+	}
+	if button.Text == "" {
+		return fmt.Errorf("text is empty")
+	}
+	if n := len(button.CallbackData); n > 64 {
+		return fmt.Errorf("callback_data must be 1-64 bytes, got %d", n)
+	}
+	if (button.CallbackGame != nil || button.Pay) && !first {
+		return fmt.Errorf("game and pay buttons must be the first button in the first row")
+	}
+
+	actions := 0
+	for _, set := range []bool{
+		button.URL != "",
+		button.LoginURL != nil,
+		button.CallbackData != "",
+		button.WebApp != nil,
+		button.SwitchInlineQuery != nil,
+		button.SwitchInlineQueryCurrentChat != nil,
+		button.CallbackGame != nil,
+		button.Pay,
+	} {
+		if set {
+			actions++
+		}
+	}
+	if actions != 1 {
+		return fmt.Errorf("button must have exactly one action, got %d", actions)
+	}
+
+	return nil
+}
+
+// ReplyKeyboardBuilder builds a ReplyKeyboardMarkup row by row.
+type ReplyKeyboardBuilder struct {
+	markup ReplyKeyboardMarkup
+	err    error
+}
+
+// NewReplyKeyboard builds an empty ReplyKeyboardBuilder.
+func NewReplyKeyboard() *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{}
+}
+
+// Row appends a row of the buttons.
+func (b *ReplyKeyboardBuilder) Row(buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	b.markup.Keyboard = append(b.markup.Keyboard, buttons)
+	return b
+}
+
+// Columns appends the buttons laid out in rows of n buttons, the last row holding the rest.
+// Build fails if n is not positive.
+func (b *ReplyKeyboardBuilder) Columns(n int, buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	if n <= 0 && b.err == nil {
+		b.err = fmt.Errorf("keyboard columns must be positive, got %d", n)
+	}
+
+	for n > 0 && len(buttons) > 0 {
+		if n > len(buttons) {
+			n = len(buttons)
+		}
+		b.markup.Keyboard = append(b.markup.Keyboard, buttons[:n:n])
+		buttons = buttons[n:]
+	}
+
+	return b
+}
+
+// Resize requests clients to resize the keyboard vertically for optimal fit.
+func (b *ReplyKeyboardBuilder) Resize() *ReplyKeyboardBuilder {
+	b.markup.ResizeKeyboard = true
+	return b
+}
+
+// OneTime requests clients to hide the keyboard as soon as it's been used.
+func (b *ReplyKeyboardBuilder) OneTime() *ReplyKeyboardBuilder {
+	b.markup.OneTimeKeyboard = true
+	return b
+}
+
+// Placeholder sets the placeholder shown in the input field when the keyboard is active, 1-64 characters.
+func (b *ReplyKeyboardBuilder) Placeholder(placeholder string) *ReplyKeyboardBuilder {
+	b.markup.InputFieldPlaceholder = placeholder
+	return b
+}
+
+// Selective shows the keyboard to specific users only.
+func (b *ReplyKeyboardBuilder) Selective() *ReplyKeyboardBuilder {
+	b.markup.Selective = true
+	return b
+}
+
+// Build validates the buttons and returns the keyboard.
+func (b *ReplyKeyboardBuilder) Build() (*ReplyKeyboardMarkup, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	rows := b.markup.Keyboard
+	if err := validateRows(len(rows), func(i int) int { return len(rows[i]) }); err != nil {
+		return nil, err
+	}
+
+	if n := utf16Len(b.markup.InputFieldPlaceholder); n > 64 {
+		return nil, fmt.Errorf("input_field_placeholder must be 1-64 characters, got %d", n)
+	}
+
+	for i, row := range rows {
+		for j, button := range row {
+			if err := validateKeyboardButton(button); err != nil {
+				return nil, fmt.Errorf("keyboard row %d button %d: %w", i, j, err)
+			}
+		}
+	}
+
+	markup := b.markup
+	return &markup, nil
+}
+
+// validateKeyboardButton checks the button has a text and at most one request.
+func validateKeyboardButton(button *KeyboardButton) error {
+	if button == nil {
+		return fmt.Errorf("button is nil")
+	}
+	if button.Text == "" {
+		return fmt.Errorf("text is empty")
+	}
+
+	actions := 0
+	for _, set := range []bool{
+		button.RequestContact,
+		button.RequestLocation,
+		button.RequestPoll != nil,
+		button.WebApp != nil,
+	} {
+		if set {
+			actions++
+		}
+	}
+	if actions > 1 {
+		return fmt.Errorf("button must have at most one action, got %d", actions)
+	}
+
+	return nil
+}
+
+// validateRows checks the keyboard has at least one row and no empty rows.
+func validateRows(rows int, buttons func(i int) int) error {
+	if rows == 0 {
+		return fmt.Errorf("keyboard has no rows")
+	}
+
+	for i := 0; i < rows; i++ {
+		if buttons(i) == 0 {
+			return fmt.Errorf("keyboard row %d is empty", i)
+		}
+	}
+
+	return nil
+}
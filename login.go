@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// loginDataFields are the fields of the authorization data sent by the Telegram Login Widget.
+var loginDataFields = []string{"id", "first_name", "last_name", "username", "photo_url", "auth_date", "hash"}
+
+// ValidateLoginData checks the authorization data sent by the Telegram Login Widget is signed with the bot token,
+// and returns the user who logged in.
+// Keys other than the fields of the data, like the parameters of the redirect URL, are ignored.
+// If maxAge is not 0, the data older than maxAge is rejected.
+// https://core.telegram.org/widgets/login#checking-authorization
+func ValidateLoginData(token string, values url.Values, maxAge time.Duration) (*User, error) {
+	secret := sha256.Sum256([]byte(token))
+
+	signed := make(url.Values, len(loginDataFields))
+	for _, k := range loginDataFields {
+		if v, ok := values[k]; ok {
+			signed[k] = v
+		}
+	}
+
+	if err := checkDataHash(signed, secret[:]); err != nil {
+		return nil, fmt.Errorf("login data: %w", err)
+	}
+
+	if _, err := parseAuthDate(values.Get("auth_date"), maxAge); err != nil {
+		return nil, fmt.Errorf("login data: %w", err)
+	}
+
+	id, err := strconv.ParseInt(values.Get("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("login data: id: %w", err)
+	}
+
+	return &User{
+		ID:        id,
+		FirstName: values.Get("first_name"),
+		LastName:  values.Get("last_name"),
+		Username:  values.Get("username"),
+	}, nil
+}
+
+// loginUserKey is the context key of the User who logged in.
+type loginUserKey struct{}
+
+// LoginHandler authorizes the redirect of the Telegram Login Widget carrying the authorization data in the query.
+// Unauthorized requests are rejected with 401 Unauthorized,
+// while the authorized ones reach next with the User in their context.
+func LoginHandler(token string, maxAge time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := ValidateLoginData(token, r.URL.Query(), maxAge)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), loginUserKey{}, user)))
+	})
+}
+
+// LoginUserFromContext returns the User stored in the context by LoginHandler.
+func LoginUserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(loginUserKey{}).(*User)
+	return user, ok
+}
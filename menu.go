@@ -0,0 +1,406 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// menuCallbackPrefix is a prefix of the callback data of Menu buttons.
+const menuCallbackPrefix = "mn:"
+
+// menuCallbackKind is the kind of the callback data of Menu buttons signed by a CallbackCodec.
+const menuCallbackKind = "menu"
+
+// Actions of the Menu buttons.
+const (
+	menuActionItem uint8 = iota
+	menuActionBack
+	menuActionHome
+)
+
+// MenuScreen is a screen of a Menu: a message text with a keyboard of items.
+type MenuScreen struct {
+	// Text of the message showing the screen.
+	Text string
+
+	// ParseMode is a mode for parsing entities in the text.
+	//
+	// Optional.
+	ParseMode ParseMode
+
+	// Items are the rows of the screen buttons.
+	Items [][]*MenuItem
+}
+
+// MenuItem is a button of a MenuScreen.
+// It opens a Screen, opens a URL or calls a Handler when pressed.
+type MenuItem struct {
+	// Text of the button.
+	Text string
+
+	// Screen opened in place of the current one when the button is pressed.
+	//
+	// Optional.
+	Screen *MenuScreen
+
+	// URL opened when the button is pressed.
+	//
+	// Optional.
+	URL string
+
+	// Handler called when the button is pressed.
+	// The callback query is already answered when the handler is called.
+	//
+	// Optional.
+	Handler func(ctx context.Context, query *CallbackQuery) error
+}
+
+// menuCallback is the callback data of a Menu button, packed by a CallbackCodec.
+// The menu is identified by the hash of its name to keep the data short.
+type menuCallback struct {
+	Menu   uint32
+	Action uint8
+	Screen uint32
+	Item   uint32
+}
+
+// Menu is a tree of screens shown in a single message and navigated with inline keyboard buttons.
+// Every screen but the root one has a back button, and screens deeper than one level have a home button.
+//
+//	settings := &telegram.MenuScreen{Text: "Settings", Items: [][]*telegram.MenuItem{{{Text: "Language", Handler: chooseLanguage}}}}
+//	root := &telegram.MenuScreen{Text: "Main menu", Items: [][]*telegram.MenuItem{{{Text: "Settings", Screen: settings}}}}
+//
+//	menu := telegram.NewMenu(client, "main", root, codec)
+//	menu.Send(ctx, chatID)
+//
+// Pass every CallbackQuery to HandleCallbackQuery to navigate the menu.
+// The path of every user through the menu is kept in a Storage.
+type Menu struct {
+	client   *Client
+	name     string
+	id       uint32
+	codec    *CallbackCodec
+	storage  Storage
+	ttl      time.Duration
+	backText string
+	homeText string
+
+	screens []*MenuScreen
+	parents []int
+	index   map[*MenuScreen]int
+}
+
+// MenuOption defines an option for a Menu.
+type MenuOption func(*Menu)
+
+// MenuOptionStorage - provide a custom storage of the users' paths through the menu.
+// Defaults to a MemoryStorage.
+func MenuOptionStorage(storage Storage) func(*Menu) {
+	return func(m *Menu) { m.storage = storage }
+}
+
+// MenuOptionTTL set the time the path of a user through the menu is kept after the last button pressed.
+// Once it expires, the path is rebuilt from the screen of the button pressed.
+// Defaults to 24 hours.
+func MenuOptionTTL(ttl time.Duration) func(*Menu) {
+	return func(m *Menu) { m.ttl = ttl }
+}
+
+// MenuOptionBackText set the text of the back button.
+// Defaults to "‹ Back".
+func MenuOptionBackText(text string) func(*Menu) {
+	return func(m *Menu) { m.backText = text }
+}
+
+// MenuOptionHomeText set the text of the home button.
+// Defaults to "« Home".
+func MenuOptionHomeText(text string) func(*Menu) {
+	return func(m *Menu) { m.homeText = text }
+}
+
+// NewMenu builds a Menu of the screens reachable from root, signing the buttons data with the codec.
+// The name tells the buttons of menus apart, so it must be unique.
+func NewMenu(client *Client, name string, root *MenuScreen, codec *CallbackCodec, options ...MenuOption) *Menu {
+	m := &Menu{
+		client:   client,
+		name:     name,
+		id:       menuID(name),
+		codec:    codec,
+		storage:  NewMemoryStorage(),
+		ttl:      24 * time.Hour,
+		backText: "‹ Back",
+		homeText: "« Home",
+		index:    make(map[*MenuScreen]int),
+	}
+
+	for _, opt := range options {
+		opt(m)
+	}
+
+	m.addScreen(root, -1)
+
+	return m
+}
+
+// addScreen indexes the screen opened from the parent screen and the screens reachable from it.
+// A screen reachable from several screens keeps the parent it's reached from first.
+func (m *Menu) addScreen(screen *MenuScreen, parent int) {
+	if _, ok := m.index[screen]; ok {
+		return
+	}
+
+	index := len(m.screens)
+	m.index[screen] = index
+	m.screens = append(m.screens, screen)
+	m.parents = append(m.parents, parent)
+
+	for _, row := range screen.Items {
+		for _, item := range row {
+			if item.Screen != nil {
+				m.addScreen(item.Screen, index)
+			}
+		}
+	}
+}
+
+// pathTo returns the path of the screens from the root one to the screen through their parents.
+func (m *Menu) pathTo(screen int) []int {
+	var path []int
+	for ; screen >= 0; screen = m.parents[screen] {
+		path = append([]int{screen}, path...)
+	}
+
+	return path
+}
+
+// Send sends the root screen of the menu to the chat.
+// Returns sent Message on success.
+func (m *Menu) Send(ctx context.Context, chatID int64) (*Message, error) {
+	keyboard, err := m.keyboard(ctx, []int{0})
+	if err != nil {
+		return nil, err
+	}
+
+	return m.client.SendMessage(ctx, &SendMessagePayload{
+		ChatID:      chatID,
+		Text:        m.screens[0].Text,
+		ParseMode:   m.screens[0].ParseMode,
+		ReplyMarkup: keyboard,
+	})
+}
+
+// HandleCallbackQuery opens the screen chosen by the user or calls the handler of the item pressed.
+// Reports whether the callback query was sent by a button of the menu.
+func (m *Menu) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) (bool, error) {
+	if !strings.HasPrefix(query.Data, menuCallbackPrefix) {
+		return false, nil
+	}
+
+	var data menuCallback
+	if err := m.codec.Decode(ctx, menuCallbackKind, strings.TrimPrefix(query.Data, menuCallbackPrefix), &data); err != nil {
+		if errors.Is(err, ErrCallbackDataTampered) {
+			return false, nil
+		}
+		return true, err
+	}
+	if data.Menu != m.id || int(data.Screen) >= len(m.screens) {
+		return false, nil
+	}
+
+	if _, err := m.client.AnswerCallbackQuery(ctx, &AnswerCallbackQueryPayload{CallbackQueryID: query.ID}); err != nil {
+		return true, err
+	}
+
+	key := m.stateKey(query)
+	path, err := m.loadPath(ctx, key)
+	if err != nil {
+		return true, err
+	}
+
+	// The button may come from an older message, so the path is restored up to the screen of the button.
+	from := int(data.Screen)
+	for i := len(path) - 1; i >= 0 && path[len(path)-1] != from; i-- {
+		path = path[:i]
+	}
+	if len(path) == 0 {
+		path = m.pathTo(from)
+	}
+
+	switch data.Action {
+	case menuActionItem:
+		item := m.item(from, int(data.Item))
+		if item == nil {
+			return true, fmt.Errorf("menu %s: screen %d has no item %d", m.name, from, data.Item)
+		}
+		if item.Screen == nil {
+			if item.Handler == nil {
+				return true, nil
+			}
+			return true, item.Handler(ctx, query)
+		}
+		path = append(path, m.index[item.Screen])
+	case menuActionBack:
+		if len(path) > 1 {
+			path = path[:len(path)-1]
+		}
+	case menuActionHome:
+		path = []int{0}
+	default:
+		return true, fmt.Errorf("menu %s: unknown action %d", m.name, data.Action)
+	}
+
+	if err := m.savePath(ctx, key, path); err != nil {
+		return true, err
+	}
+
+	keyboard, err := m.keyboard(ctx, path)
+	if err != nil {
+		return true, err
+	}
+
+	screen := m.screens[path[len(path)-1]]
+	payload := &EditMessageTextPayload{
+		InlineMessageID: query.InlineMessageID,
+		Text:            screen.Text,
+		ParseMode:       screen.ParseMode,
+		ReplyMarkup:     keyboard,
+	}
+	if query.Message != nil {
+		payload.ChatID, payload.MessageID = query.Message.Chat.ID, query.Message.MessageID
+	}
+
+	_, err = m.client.EditMessageText(ctx, payload)
+	return true, err
+}
+
+// item returns the item of the screen at the row-major index, or nil if there is none.
+func (m *Menu) item(screen, index int) *MenuItem {
+	for _, row := range m.screens[screen].Items {
+		if index < len(row) {
+			return row[index]
+		}
+		index -= len(row)
+	}
+
+	return nil
+}
+
+// keyboard returns the keyboard of the last screen of the path.
+func (m *Menu) keyboard(ctx context.Context, path []int) (*InlineKeyboardMarkup, error) {
+	screen := path[len(path)-1]
+
+	var keyboard [][]*InlineKeyboardButton
+	index := 0
+	for _, row := range m.screens[screen].Items {
+		buttons := make([]*InlineKeyboardButton, 0, len(row))
+		for _, item := range row {
+			button := &InlineKeyboardButton{Text: item.Text, URL: item.URL}
+			if item.URL == "" {
+				data, err := m.callbackData(ctx, menuActionItem, screen, index)
+				if err != nil {
+					return nil, err
+				}
+				button.CallbackData = data
+			}
+
+			buttons = append(buttons, button)
+			index++
+		}
+		keyboard = append(keyboard, buttons)
+	}
+
+	var nav []*InlineKeyboardButton
+	if len(path) > 1 {
+		data, err := m.callbackData(ctx, menuActionBack, screen, 0)
+		if err != nil {
+			return nil, err
+		}
+		nav = append(nav, Button.Callback(m.backText, data))
+	}
+	if len(path) > 2 {
+		data, err := m.callbackData(ctx, menuActionHome, screen, 0)
+		if err != nil {
+			return nil, err
+		}
+		nav = append(nav, Button.Callback(m.homeText, data))
+	}
+	if len(nav) > 0 {
+		keyboard = append(keyboard, nav)
+	}
+
+	return &InlineKeyboardMarkup{InlineKeyboard: keyboard}, nil
+}
+
+// callbackData returns the signed callback data of a button of the screen.
+func (m *Menu) callbackData(ctx context.Context, action uint8, screen, item int) (string, error) {
+	data, err := m.codec.encode(ctx, menuCallbackKind, &menuCallback{
+		Menu:   m.id,
+		Action: action,
+		Screen: uint32(screen),
+		Item:   uint32(item),
+	}, MaxCallbackDataLength-len(menuCallbackPrefix))
+	if err != nil {
+		return "", err
+	}
+
+	return menuCallbackPrefix + data, nil
+}
+
+// menuID returns the hash of the menu name identifying the menu in the callback data.
+func menuID(name string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+
+	return h.Sum32()
+}
+
+// stateKey returns the Storage key of the path of the user who sent the callback query.
+func (m *Menu) stateKey(query *CallbackQuery) string {
+	chat := query.InlineMessageID
+	if query.Message != nil {
+		chat = strconv.FormatInt(query.Message.Chat.ID, 10)
+	}
+
+	return "menu:" + m.name + ":" + chat + ":" + strconv.FormatInt(query.From.ID, 10)
+}
+
+// loadPath returns the stored path of the screens opened, or nil if there is none.
+func (m *Menu) loadPath(ctx context.Context, key string) ([]int, error) {
+	value, err := m.storage.Get(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var path []int
+	if err := json.Unmarshal(value, &path); err != nil {
+		return nil, err
+	}
+
+	// Drop the screens of a menu changed since the path was stored.
+	for i, screen := range path {
+		if screen < 0 || screen >= len(m.screens) {
+			return path[:i], nil
+		}
+	}
+
+	return path, nil
+}
+
+// savePath stores the path of the screens opened.
+func (m *Menu) savePath(ctx context.Context, key string, path []int) error {
+	value, err := json.Marshal(path)
+	if err != nil {
+		return err
+	}
+
+	return m.storage.Set(ctx, key, value, m.ttl)
+}
@@ -3,6 +3,8 @@ package telegram
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 )
 
 // GetMe returns basic information about the bot in form of a User object.
@@ -17,6 +19,58 @@ func (c *Client) GetMe(ctx context.Context) (*User, error) {
 	return &user, err
 }
 
+// GetFilePayload represents data for GetFile method.
+type GetFilePayload struct {
+	// FileID is a file identifier to get information about.
+	FileID string `json:"file_id"`
+}
+
+// GetFile get basic information about a file and prepare it for downloading.
+// For the moment, bots can download files of up to 20MB in size.
+// The file can then be downloaded with DownloadFile.
+// Returns File on success.
+func (c *Client) GetFile(ctx context.Context, payload *GetFilePayload) (*File, error) {
+	resp, err := c.MakeRequest(ctx, "getFile", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var file File
+	err = json.Unmarshal(resp.Result, &file)
+	return &file, err
+}
+
+// LogOut log out from the cloud Bot API server before launching the bot locally.
+// You must log out the bot before running it locally, otherwise there is no guarantee that the bot will receive updates.
+// After a successful call, you can immediately log in on a local server,
+// but will not be able to log in back to the cloud Bot API server for 10 minutes.
+// Returns True on success.
+func (c *Client) LogOut(ctx context.Context) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "logOut", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+// Close close the bot instance before moving it from one local server to another.
+// You need to delete the webhook before calling this method to ensure that the bot isn't launched again after server restart.
+// The method will return error 429 in the first 10 minutes after the bot is launched.
+// Returns True on success.
+func (c *Client) Close(ctx context.Context) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "close", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
 type SendMessagePayload struct {
 	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
 	ChatID int64 `json:"chat_id"`
@@ -68,7 +122,7 @@ type SendMessagePayload struct {
 	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
 	//
 	// Optional.
-	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
 // SendMessage sending text messages.
@@ -84,6 +138,252 @@ func (c *Client) SendMessage(ctx context.Context, payload *SendMessagePayload) (
 	return &message, err
 }
 
+type ForwardMessagePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// FromChatID is a unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername).
+	FromChatID int64 `json:"from_chat_id"`
+
+	// MessageID is a message identifier in the chat specified in FromChatID.
+	MessageID int64 `json:"message_id"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the forwarded message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+}
+
+// ForwardMessage forwarding messages of any kind.
+// Service messages can't be forwarded.
+// Returns sent Message on success.
+func (c *Client) ForwardMessage(ctx context.Context, payload *ForwardMessagePayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "forwardMessage", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type CopyMessagePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// FromChatID is a unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername).
+	FromChatID int64 `json:"from_chat_id"`
+
+	// MessageID is a message identifier in the chat specified in FromChatID.
+	MessageID int64 `json:"message_id"`
+
+	// Caption is a new caption for media, 0-1024 characters after entities parsing.
+	// If not specified, the original caption is kept.
+	//
+	// Optional.
+	Caption *string `json:"caption,omitempty"`
+
+	// Mode for parsing entities in the new caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a JSON-serialized list of special entities that appear in the new caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// CopyMessage copying messages of any kind.
+// Service messages and invoice messages can't be copied.
+// The method is analogous to the method ForwardMessage,
+// but the copied message doesn't have a link to the original message.
+// Returns the MessageID of the sent message on success.
+func (c *Client) CopyMessage(ctx context.Context, payload *CopyMessagePayload) (*MessageID, error) {
+	resp, err := c.MakeRequest(ctx, "copyMessage", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var messageID MessageID
+	err = json.Unmarshal(resp.Result, &messageID)
+	return &messageID, err
+}
+
+type SendMediaGroupPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Media is a list of 2-10 InputMediaAudio, InputMediaDocument, InputMediaPhoto and InputMediaVideo to be sent.
+	// Documents and audio files can be only grouped in an album with messages of the same type.
+	Media []InputMedia `json:"media"`
+
+	// DisableNotification sends the messages silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent messages from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+}
+
+func (p *SendMediaGroupPayload) inputFiles() []*InputFile {
+	var files []*InputFile
+	for _, m := range p.Media {
+		files = append(files, m.inputFiles()...)
+	}
+
+	return files
+}
+
+// validate checks the number of media in the group and the types of media which can be grouped together.
+func (p *SendMediaGroupPayload) validate() error {
+	if len(p.Media) < 2 || len(p.Media) > 10 {
+		return fmt.Errorf("media group must include 2-10 items, got %d", len(p.Media))
+	}
+
+	var kind InputMediaType
+	for i, m := range p.Media {
+		if m == nil {
+			return fmt.Errorf("media group item %d is nil", i)
+		}
+
+		t := m.inputMediaType()
+		if t == InputMediaTypeAnimation {
+			return fmt.Errorf("media group item %d: animations can't be sent in a media group", i)
+		}
+
+		// Photos and videos can be mixed, documents and audio files can be grouped only with the same type.
+		if t == InputMediaTypeVideo {
+			t = InputMediaTypePhoto
+		}
+
+		if i > 0 && t != kind {
+			return fmt.Errorf("media group item %d: %s can't be grouped with %s", i, m.inputMediaType(), p.Media[0].inputMediaType())
+		}
+		kind = t
+	}
+
+	return nil
+}
+
+// SendMediaGroup sending a group of photos, videos, documents or audios as an album.
+// Returns an array of sent Messages on success.
+func (c *Client) SendMediaGroup(ctx context.Context, payload *SendMediaGroupPayload) ([]*Message, error) {
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.MakeRequest(ctx, "sendMediaGroup", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*Message
+	err = json.Unmarshal(resp.Result, &messages)
+	return messages, err
+}
+
+type AnswerCallbackQueryPayload struct {
+	// CallbackQueryID is a unique identifier for the query to be answered.
+	CallbackQueryID string `json:"callback_query_id"`
+
+	// Text of the notification.
+	// If not specified, nothing will be shown to the user, 0-200 characters.
+	//
+	// Optional.
+	Text string `json:"text,omitempty"`
+
+	// ShowAlert if True, an alert will be shown by the client instead of a notification at the top of the chat screen.
+	// Defaults to false.
+	//
+	// Optional.
+	ShowAlert bool `json:"show_alert,omitempty"`
+
+	// URL that will be opened by the user's client.
+	// If you have created a Game and accepted the conditions via @BotFather,
+	// specify the URL that opens your game - note that this will only work if the query comes from a CallbackGame button.
+	// Otherwise, you may use links like t.me/your_bot?start=XXXX that open your bot with a parameter.
+	//
+	// Optional.
+	URL string `json:"url,omitempty"`
+
+	// CacheTime is the maximum amount of time in seconds that the result of the callback query may be cached client-side.
+	// Telegram apps will support caching starting in version 3.14.
+	// Defaults to 0.
+	//
+	// Optional.
+	CacheTime int `json:"cache_time,omitempty"`
+}
+
+// AnswerCallbackQuery send answers to callback queries sent from inline keyboards.
+// The answer will be displayed to the user as a notification at the top of the chat screen or as an alert.
+// Returns True on success.
+func (c *Client) AnswerCallbackQuery(ctx context.Context, payload *AnswerCallbackQueryPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "answerCallbackQuery", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
 type AnswerWebAppQueryPayload struct {
 	// WebAppQueryId is a unique identifier for the query to be answered.
 	WebAppQueryId string `json:"web_app_query_id"`
@@ -106,23 +406,30 @@ func (c *Client) AnswerWebAppQuery(ctx context.Context, payload *AnswerWebAppQue
 	return &webAppMessage, err
 }
 
-type SetChatMenuButtonPayload struct {
-	// ChatID is a unique identifier for the target private chat.
-	// If not specified, default bot's menu button will be changed.
+type SetMyCommandsPayload struct {
+	// Commands is a JSON-serialized list of bot commands to be set as the list of the bot's commands.
+	// At most 100 commands can be specified.
+	Commands []*BotCommand `json:"commands"`
+
+	// Scope is a JSON-serialized object, describing scope of users for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault.
 	//
 	// Optional.
-	ChatID int64 `json:"chat_id,omitempty"`
+	Scope BotCommandScope `json:"scope,omitempty"`
 
-	// MenuButton is a JSON-serialized object for the new bot's menu button.
-	// Defaults to MenuButtonDefault.
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands.
+	//
 	// Optional.
-	MenuButton *MenuButton `json:"menu_button,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
 }
 
-// SetChatMenuButton change the bot's menu button in a ChatTypePrivate, or the default menu button.
+// SetMyCommands change the list of the bot's commands.
+// See https://core.telegram.org/bots#commands for more details about bot commands.
 // Returns True on success.
-func (c *Client) SetChatMenuButton(ctx context.Context, payload *SetChatMenuButtonPayload) (bool, error) {
-	resp, err := c.MakeRequest(ctx, "setChatMenuButton", payload)
+func (c *Client) SetMyCommands(ctx context.Context, payload *SetMyCommandsPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setMyCommands", payload)
 	if err != nil {
 		return false, err
 	}
@@ -132,47 +439,80 @@ func (c *Client) SetChatMenuButton(ctx context.Context, payload *SetChatMenuButt
 	return success, err
 }
 
-type GetChatMenuButtonPayload struct {
-	// ChatID is a unique identifier for the target private chat.
-	// If not specified, default bot's menu button will be returned.
+type DeleteMyCommandsPayload struct {
+	// Scope is a JSON-serialized object, describing scope of users for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault.
 	//
 	// Optional.
-	ChatID int64 `json:"chat_id,omitempty"`
+	Scope BotCommandScope `json:"scope,omitempty"`
+
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
 }
 
-// GetChatMenuButton get the current value of the bot's menu button in a private chat, or the default menu button.
-// Returns MenuButton on success.
-func (c *Client) GetChatMenuButton(ctx context.Context, payload *GetChatMenuButtonPayload) (*MenuButton, error) {
-	resp, err := c.MakeRequest(ctx, "setChatMenuButton", payload)
+// DeleteMyCommands delete the list of the bot's commands for the given scope and user language.
+// After deletion, higher level commands will be shown to affected users.
+// Returns True on success.
+func (c *Client) DeleteMyCommands(ctx context.Context, payload *DeleteMyCommandsPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "deleteMyCommands", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetMyCommandsPayload struct {
+	// Scope is a JSON-serialized object, describing scope of users.
+	// Defaults to BotCommandScopeDefault.
+	//
+	// Optional.
+	Scope BotCommandScope `json:"scope,omitempty"`
+
+	// LanguageCode is a two-letter ISO 639-1 language code or an empty string.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyCommands get the current list of the bot's commands for the given scope and user language.
+// Returns Array of BotCommand on success.
+// If commands aren't set, an empty list is returned.
+func (c *Client) GetMyCommands(ctx context.Context, payload *GetMyCommandsPayload) ([]*BotCommand, error) {
+	resp, err := c.MakeRequest(ctx, "getMyCommands", payload)
 	if err != nil {
 		return nil, err
 	}
 
-	var menuButton MenuButton
-	err = json.Unmarshal(resp.Result, &menuButton)
-	return &menuButton, err
+	var commands []*BotCommand
+	err = json.Unmarshal(resp.Result, &commands)
+	return commands, err
 }
 
-type SetMyDefaultAdministratorRightsPayload struct {
-	// Rights is a JSON-serialized object describing new default administrator rights.
-	// If not specified, the default administrator rights will be cleared.
+type SetMyNamePayload struct {
+	// Name is a new bot name; 0-64 characters.
+	// Pass an empty string to remove the dedicated name for the given language.
 	//
 	// Optional.
-	Rights *ChatAdministratorRights `json:"rights,omitempty"`
+	Name string `json:"name,omitempty"`
 
-	// ForChannels is used to change the default administrator rights of the bot in channels.
-	// Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, the name will be shown to all users for whose language there is no dedicated name.
 	//
 	// Optional.
-	ForChannels bool `json:"for_channels,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
 }
 
-// SetMyDefaultAdministratorRights change the default administrator rights requested by the bot
-// when it's added as an administrator to groups or channels.
-// These rights will be suggested to users, but they are free to modify the list before adding the bot.
+// SetMyName change the bot's name.
 // Returns True on success.
-func (c *Client) SetMyDefaultAdministratorRights(ctx context.Context, payload *SetMyDefaultAdministratorRightsPayload) (bool, error) {
-	resp, err := c.MakeRequest(ctx, "setMyDefaultAdministratorRights", payload)
+func (c *Client) SetMyName(ctx context.Context, payload *SetMyNamePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setMyName", payload)
 	if err != nil {
 		return false, err
 	}
@@ -182,23 +522,2122 @@ func (c *Client) SetMyDefaultAdministratorRights(ctx context.Context, payload *S
 	return success, err
 }
 
-type GetMyDefaultAdministratorRightsPayload struct {
-	// ForChannels is used to change the default administrator rights of the bot in channels.
-	// Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+type GetMyNamePayload struct {
+	// LanguageCode is a two-letter ISO 639-1 language code or an empty string.
 	//
 	// Optional.
-	ForChannels bool `json:"for_channels,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
 }
 
-// GetMyDefaultAdministratorRights get the current default administrator rights of the bot.
-// Returns ChatAdministratorRights on success.
-func (c *Client) GetMyDefaultAdministratorRights(ctx context.Context, payload *GetMyDefaultAdministratorRightsPayload) (*ChatAdministratorRights, error) {
-	resp, err := c.MakeRequest(ctx, "getMyDefaultAdministratorRights", payload)
+// GetMyName get the current bot name for the given user language.
+// Returns BotName on success.
+func (c *Client) GetMyName(ctx context.Context, payload *GetMyNamePayload) (*BotName, error) {
+	resp, err := c.MakeRequest(ctx, "getMyName", payload)
 	if err != nil {
 		return nil, err
 	}
 
-	var chatAdministratorRights ChatAdministratorRights
-	err = json.Unmarshal(resp.Result, &chatAdministratorRights)
-	return &chatAdministratorRights, err
+	var botName BotName
+	err = json.Unmarshal(resp.Result, &botName)
+	return &botName, err
+}
+
+type SetMyDescriptionPayload struct {
+	// Description is a new bot description; 0-512 characters.
+	// Pass an empty string to remove the dedicated description for the given language.
+	//
+	// Optional.
+	Description string `json:"description,omitempty"`
+
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, the description will be applied to all users for whose language there is no dedicated description.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyDescription change the bot's description, which is shown in the chat with the bot if the chat is empty.
+// Returns True on success.
+func (c *Client) SetMyDescription(ctx context.Context, payload *SetMyDescriptionPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setMyDescription", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetMyDescriptionPayload struct {
+	// LanguageCode is a two-letter ISO 639-1 language code or an empty string.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyDescription get the current bot description for the given user language.
+// Returns BotDescription on success.
+func (c *Client) GetMyDescription(ctx context.Context, payload *GetMyDescriptionPayload) (*BotDescription, error) {
+	resp, err := c.MakeRequest(ctx, "getMyDescription", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var botDescription BotDescription
+	err = json.Unmarshal(resp.Result, &botDescription)
+	return &botDescription, err
+}
+
+type SetMyShortDescriptionPayload struct {
+	// ShortDescription is a new short description for the bot; 0-120 characters.
+	// Pass an empty string to remove the dedicated short description for the given language.
+	//
+	// Optional.
+	ShortDescription string `json:"short_description,omitempty"`
+
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	// If empty, the short description will be applied to all users for whose language there is no dedicated short description.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyShortDescription change the bot's short description,
+// which is shown on the bot's profile page and is sent together with the link when users share the bot.
+// Returns True on success.
+func (c *Client) SetMyShortDescription(ctx context.Context, payload *SetMyShortDescriptionPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setMyShortDescription", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetMyShortDescriptionPayload struct {
+	// LanguageCode is a two-letter ISO 639-1 language code or an empty string.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyShortDescription get the current bot short description for the given user language.
+// Returns BotShortDescription on success.
+func (c *Client) GetMyShortDescription(ctx context.Context, payload *GetMyShortDescriptionPayload) (*BotShortDescription, error) {
+	resp, err := c.MakeRequest(ctx, "getMyShortDescription", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var botShortDescription BotShortDescription
+	err = json.Unmarshal(resp.Result, &botShortDescription)
+	return &botShortDescription, err
+}
+
+type SetChatMenuButtonPayload struct {
+	// ChatID is a unique identifier for the target private chat.
+	// If not specified, default bot's menu button will be changed.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MenuButton is a JSON-serialized object for the new bot's menu button.
+	// Defaults to MenuButtonDefault.
+	// Optional.
+	MenuButton *MenuButton `json:"menu_button,omitempty"`
+}
+
+// SetChatMenuButton change the bot's menu button in a ChatTypePrivate, or the default menu button.
+// Returns True on success.
+func (c *Client) SetChatMenuButton(ctx context.Context, payload *SetChatMenuButtonPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatMenuButton", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetChatMenuButtonPayload struct {
+	// ChatID is a unique identifier for the target private chat.
+	// If not specified, default bot's menu button will be returned.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+}
+
+// GetChatMenuButton get the current value of the bot's menu button in a private chat, or the default menu button.
+// Returns MenuButton on success.
+func (c *Client) GetChatMenuButton(ctx context.Context, payload *GetChatMenuButtonPayload) (*MenuButton, error) {
+	resp, err := c.MakeRequest(ctx, "setChatMenuButton", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var menuButton MenuButton
+	err = json.Unmarshal(resp.Result, &menuButton)
+	return &menuButton, err
+}
+
+type SetMyDefaultAdministratorRightsPayload struct {
+	// Rights is a JSON-serialized object describing new default administrator rights.
+	// If not specified, the default administrator rights will be cleared.
+	//
+	// Optional.
+	Rights *ChatAdministratorRights `json:"rights,omitempty"`
+
+	// ForChannels is used to change the default administrator rights of the bot in channels.
+	// Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+	//
+	// Optional.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+// SetMyDefaultAdministratorRights change the default administrator rights requested by the bot
+// when it's added as an administrator to groups or channels.
+// These rights will be suggested to users, but they are free to modify the list before adding the bot.
+// Returns True on success.
+func (c *Client) SetMyDefaultAdministratorRights(ctx context.Context, payload *SetMyDefaultAdministratorRightsPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setMyDefaultAdministratorRights", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetMyDefaultAdministratorRightsPayload struct {
+	// ForChannels is used to change the default administrator rights of the bot in channels.
+	// Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+	//
+	// Optional.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+// GetMyDefaultAdministratorRights get the current default administrator rights of the bot.
+// Returns ChatAdministratorRights on success.
+func (c *Client) GetMyDefaultAdministratorRights(ctx context.Context, payload *GetMyDefaultAdministratorRightsPayload) (*ChatAdministratorRights, error) {
+	resp, err := c.MakeRequest(ctx, "getMyDefaultAdministratorRights", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var chatAdministratorRights ChatAdministratorRights
+	err = json.Unmarshal(resp.Result, &chatAdministratorRights)
+	return &chatAdministratorRights, err
+}
+
+type SendLocationPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Latitude of the location.
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the location.
+	Longitude float64 `json:"longitude"`
+
+	// HorizontalAccuracy is the radius of uncertainty for the location, measured in meters; 0-1500.
+	//
+	// Optional.
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// LivePeriod is a period in seconds for which the location will be updated, should be between 60 and 86400.
+	// See Live Locations: https://telegram.org/blog/live-locations
+	//
+	// Optional.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// Heading is a direction in which the user is moving, in degrees; 1-360.
+	// For live locations only.
+	//
+	// Optional.
+	Heading int `json:"heading,omitempty"`
+
+	// ProximityAlertRadius is a maximum distance in meters for proximity alerts about approaching another chat member; 1-100000.
+	// For live locations only.
+	//
+	// Optional.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendLocation sending point on the map.
+// Returns sent Message on success.
+func (c *Client) SendLocation(ctx context.Context, payload *SendLocationPayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendLocation", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type EditMessageLiveLocationPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message to edit.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Latitude of new location.
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of new location.
+	Longitude float64 `json:"longitude"`
+
+	// HorizontalAccuracy is the radius of uncertainty for the location, measured in meters; 0-1500.
+	//
+	// Optional.
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Heading is a direction in which the user is moving, in degrees; 1-360.
+	//
+	// Optional.
+	Heading int `json:"heading,omitempty"`
+
+	// ProximityAlertRadius is a maximum distance in meters for proximity alerts about approaching another chat member; 1-100000.
+	//
+	// Optional.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageLiveLocation editing live location messages.
+// A location can be edited until its LivePeriod expires or editing is explicitly disabled by a call to StopMessageLiveLocation.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) EditMessageLiveLocation(ctx context.Context, payload *EditMessageLiveLocationPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "editMessageLiveLocation", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type StopMessageLiveLocationPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message with live location to stop.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopMessageLiveLocation stop updating a live location message before LivePeriod expires.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) StopMessageLiveLocation(ctx context.Context, payload *StopMessageLiveLocationPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "stopMessageLiveLocation", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type SendVenuePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Latitude of the venue.
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the venue.
+	Longitude float64 `json:"longitude"`
+
+	// Title is a name of the venue.
+	Title string `json:"title"`
+
+	// Address of the venue.
+	Address string `json:"address"`
+
+	// FoursquareID is a Foursquare identifier of the venue.
+	//
+	// Optional.
+	FoursquareID string `json:"foursquare_id,omitempty"`
+
+	// FoursquareType is a Foursquare type of the venue, if known.
+	// For example, “arts_entertainment/default”, “arts_entertainment/aquarium” or “food/icecream”.
+	//
+	// Optional.
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// GooglePlaceID is a Google Places identifier of the venue.
+	//
+	// Optional.
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+
+	// GooglePlaceType is a Google Places type of the venue.
+	// See: https://developers.google.com/maps/documentation/places/web-service/supported_types
+	//
+	// Optional.
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendVenue sending information about a venue.
+// Returns sent Message on success.
+func (c *Client) SendVenue(ctx context.Context, payload *SendVenuePayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendVenue", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type SendContactPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// PhoneNumber is a contact's phone number.
+	PhoneNumber string `json:"phone_number"`
+
+	// FirstName is a contact's first name.
+	FirstName string `json:"first_name"`
+
+	// LastName is a contact's last name.
+	//
+	// Optional.
+	LastName string `json:"last_name,omitempty"`
+
+	// VCard is an additional data about the contact in the form of a vCard, 0-2048 bytes.
+	//
+	// Optional.
+	VCard string `json:"vcard,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendContact sending phone contacts.
+// Returns sent Message on success.
+func (c *Client) SendContact(ctx context.Context, payload *SendContactPayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendContact", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type SendDicePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Emoji on which the dice throw animation is based.
+	// Currently, must be one of DiceEmojiDice, DiceEmojiDarts, DiceEmojiBowling,
+	// DiceEmojiBasketball, DiceEmojiFootball or DiceEmojiSlotMachine.
+	// Defaults to DiceEmojiDice.
+	//
+	// Optional.
+	Emoji string `json:"emoji,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendDice sending an animated emoji that will display a random value.
+// Returns sent Message on success.
+func (c *Client) SendDice(ctx context.Context, payload *SendDicePayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendDice", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type SendPollPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Question is a poll question, 1-300 characters.
+	Question string `json:"question"`
+
+	// Options is a list of answer options, 2-10 strings 1-100 characters each.
+	Options []string `json:"options"`
+
+	// IsAnonymous is True, if the poll needs to be anonymous.
+	// Defaults to True.
+	//
+	// Optional.
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+
+	// Type is a poll type, PollTypeQuiz or PollTypeRegular.
+	// Defaults to PollTypeRegular.
+	//
+	// Optional.
+	Type PollType `json:"type,omitempty"`
+
+	// AllowsMultipleAnswers is True, if the poll allows multiple answers, ignored for polls in quiz mode.
+	//
+	// Optional.
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers,omitempty"`
+
+	// CorrectOptionID is a 0-based identifier of the correct answer option.
+	// Required for polls in quiz mode.
+	//
+	// Optional.
+	CorrectOptionID *int `json:"correct_option_id,omitempty"`
+
+	// Explanation is a text that is shown when a user chooses an incorrect answer or taps on the lamp icon
+	// in a quiz-style poll, 0-200 characters with at most 2 line feeds after entities parsing.
+	//
+	// Optional.
+	Explanation string `json:"explanation,omitempty"`
+
+	// ExplanationParseMode is a mode for parsing entities in the explanation.
+	//
+	// Optional.
+	ExplanationParseMode ParseMode `json:"explanation_parse_mode,omitempty"`
+
+	// ExplanationEntities is a list of special entities that appear in the poll explanation,
+	// which can be specified instead of ExplanationParseMode.
+	//
+	// Optional.
+	ExplanationEntities []*MessageEntity `json:"explanation_entities,omitempty"`
+
+	// OpenPeriod is an amount of time in seconds the poll will be active after creation, 5-600.
+	// Can't be used together with CloseDate.
+	//
+	// Optional.
+	OpenPeriod int `json:"open_period,omitempty"`
+
+	// CloseDate is a point in time when the poll will be automatically closed.
+	// Must be at least 5 and no more than 600 seconds in the future.
+	// Can't be used together with OpenPeriod.
+	//
+	// Optional.
+	CloseDate time.Time `json:"-"`
+
+	// IsClosed pass True, if the poll needs to be immediately closed.
+	// This can be useful for poll preview.
+	//
+	// Optional.
+	IsClosed bool `json:"is_closed,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Additional interface options.
+	// A JSON-serialized object for an inline keyboard, custom reply keyboard,
+	// instructions to remove reply keyboard or to force a reply from the user.
+	// One of: InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove, ForceReply.
+	//
+	// Optional.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// MarshalJSON encodes the payload with CloseDate in Unix time.
+func (p *SendPollPayload) MarshalJSON() ([]byte, error) {
+	type alias SendPollPayload
+	return json.Marshal(&struct {
+		*alias
+		CloseDate int64 `json:"close_date,omitempty"`
+	}{(*alias)(p), unixTime(p.CloseDate)})
+}
+
+// SendPoll sending a native poll.
+// Returns sent Message on success.
+func (c *Client) SendPoll(ctx context.Context, payload *SendPollPayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendPoll", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+type EditMessageTextPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message to edit.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Text is a new text of the message, 1-4096 characters after entities parsing.
+	Text string `json:"text"`
+
+	// Mode for parsing entities in the message text.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// Entities is a JSON-serialized list of special entities that appear in message text,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	Entities []*MessageEntity `json:"entities,omitempty"`
+
+	// DisableWebPagePreview disables link previews for links in this message.
+	//
+	// Optional.
+	DisableWebPagePreview bool `json:"disable_web_page_preview,omitempty"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageText editing text and game messages.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) EditMessageText(ctx context.Context, payload *EditMessageTextPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "editMessageText", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type EditMessageCaptionPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message to edit.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Caption is a new caption of the message, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// Mode for parsing entities in the message caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageCaption editing captions of messages.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) EditMessageCaption(ctx context.Context, payload *EditMessageCaptionPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "editMessageCaption", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type EditMessageMediaPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message to edit.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Media is a new media content of the message.
+	// When an inline message is edited, a new file can't be uploaded,
+	// use a previously uploaded file via its file_id or specify a URL.
+	Media InputMedia `json:"media"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+func (p *EditMessageMediaPayload) inputFiles() []*InputFile {
+	if p.Media == nil {
+		return nil
+	}
+
+	return p.Media.inputFiles()
+}
+
+// EditMessageMedia editing animation, audio, document, photo, or video messages.
+// If a message is part of a message album, then it can be edited only to an audio for audio albums,
+// only to a document for document albums and to a photo or a video otherwise.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) EditMessageMedia(ctx context.Context, payload *EditMessageMediaPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "editMessageMedia", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type EditMessageReplyMarkupPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	ChatID int64 `json:"chat_id,omitempty"`
+
+	// MessageID is an identifier of the message to edit.
+	// Required if InlineMessageID is not specified.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+
+	// InlineMessageID is an identifier of the inline message.
+	// Required if ChatID and MessageID are not specified.
+	//
+	// Optional.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// ReplyMarkup is a new inline keyboard.
+	// If not specified, the inline keyboard is removed.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkup editing only the reply markup of messages.
+// Returns EditedMessage on success, holding the edited Message if it is not an inline message.
+func (c *Client) EditMessageReplyMarkup(ctx context.Context, payload *EditMessageReplyMarkupPayload) (*EditedMessage, error) {
+	resp, err := c.MakeRequest(ctx, "editMessageReplyMarkup", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEditedMessage(resp.Result)
+}
+
+type DeleteMessagePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// MessageID is an identifier of the message to delete.
+	MessageID int64 `json:"message_id"`
+}
+
+// DeleteMessage delete a message, including service messages, with the following limitations:
+// a message can only be deleted if it was sent less than 48 hours ago;
+// bots can delete outgoing messages in private chats, groups, and supergroups;
+// bots can delete incoming messages in private chats;
+// if the bot is an administrator of a group or a supergroup, it can delete any message there.
+// Returns True on success.
+func (c *Client) DeleteMessage(ctx context.Context, payload *DeleteMessagePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "deleteMessage", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type BanChatMemberPayload struct {
+	// ChatID is a unique identifier for the target group or username of the target supergroup or channel
+	// (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+
+	// UntilDate is a date when the user will be unbanned.
+	// If user is banned for more than 366 days or less than 30 seconds from the current time they are considered to be banned forever.
+	// Applied for supergroups and channels only.
+	//
+	// Optional.
+	UntilDate time.Time `json:"-"`
+
+	// RevokeMessages pass True to delete all messages from the chat for the user that is being removed.
+	// If False, the user will be able to see messages in the group that were sent before the user was removed.
+	// Always True for supergroups and channels.
+	//
+	// Optional.
+	RevokeMessages bool `json:"revoke_messages,omitempty"`
+}
+
+// MarshalJSON encodes the payload with UntilDate in Unix time.
+func (p *BanChatMemberPayload) MarshalJSON() ([]byte, error) {
+	type alias BanChatMemberPayload
+	return json.Marshal(&struct {
+		*alias
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{(*alias)(p), unixTime(p.UntilDate)})
+}
+
+// BanChatMember ban a user in a group, a supergroup or a channel.
+// In the case of supergroups and channels, the user will not be able to return to the chat on their own using invite links, etc.,
+// unless unbanned first.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) BanChatMember(ctx context.Context, payload *BanChatMemberPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "banChatMember", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type UnbanChatMemberPayload struct {
+	// ChatID is a unique identifier for the target group or username of the target supergroup or channel
+	// (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+
+	// OnlyIfBanned do nothing if the user is not banned.
+	//
+	// Optional.
+	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
+}
+
+// UnbanChatMember unban a previously banned user in a supergroup or channel.
+// The user will not return to the group or channel automatically, but will be able to join via link, etc.
+// The bot must be an administrator for this to work.
+// By default, this method guarantees that after the call the user is not a member of the chat, but will be able to join it.
+// So if the user is a member of the chat they will also be removed from the chat.
+// If you don't want this, use OnlyIfBanned.
+// Returns True on success.
+func (c *Client) UnbanChatMember(ctx context.Context, payload *UnbanChatMemberPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "unbanChatMember", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type RestrictChatMemberPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+
+	// Permissions is a JSON-serialized object for new user permissions.
+	Permissions *ChatPermissions `json:"permissions"`
+
+	// UntilDate is a date when restrictions will be lifted for the user.
+	// If user is restricted for more than 366 days or less than 30 seconds from the current time,
+	// they are considered to be restricted forever.
+	//
+	// Optional.
+	UntilDate time.Time `json:"-"`
+}
+
+// MarshalJSON encodes the payload with UntilDate in Unix time.
+func (p *RestrictChatMemberPayload) MarshalJSON() ([]byte, error) {
+	type alias RestrictChatMemberPayload
+	return json.Marshal(&struct {
+		*alias
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{(*alias)(p), unixTime(p.UntilDate)})
+}
+
+// RestrictChatMember restrict a user in a supergroup.
+// The bot must be an administrator in the supergroup for this to work and must have the appropriate administrator rights.
+// Pass True for all permissions to lift restrictions from a user.
+// Returns True on success.
+func (c *Client) RestrictChatMember(ctx context.Context, payload *RestrictChatMemberPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "restrictChatMember", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type PromoteChatMemberPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+
+	// IsAnonymous pass True, if the administrator's presence in the chat is hidden.
+	//
+	// Optional.
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+
+	// CanManageChat pass True, if the administrator can access the chat event log, chat statistics,
+	// message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode.
+	// Implied by any other administrator privilege.
+	//
+	// Optional.
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+
+	// CanPostMessages pass True, if the administrator can create channel posts, channels only.
+	//
+	// Optional.
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+
+	// CanEditMessages pass True, if the administrator can edit messages of other users and can pin messages, channels only.
+	//
+	// Optional.
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+
+	// CanDeleteMessages pass True, if the administrator can delete messages of other users.
+	//
+	// Optional.
+	CanDeleteMessages bool `json:"can_delete_messages,omitempty"`
+
+	// CanManageVideoChats pass True, if the administrator can manage video chats.
+	//
+	// Optional.
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+
+	// CanRestrictMembers pass True, if the administrator can restrict, ban or unban chat members.
+	//
+	// Optional.
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"`
+
+	// CanPromoteMembers pass True, if the administrator can add new administrators with a subset of their own privileges
+	// or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by him).
+	//
+	// Optional.
+	CanPromoteMembers bool `json:"can_promote_members,omitempty"`
+
+	// CanChangeInfo pass True, if the administrator can change chat title, photo and other settings.
+	//
+	// Optional.
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+
+	// CanInviteUsers pass True, if the administrator can invite new users to the chat.
+	//
+	// Optional.
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+
+	// CanPinMessages pass True, if the administrator can pin messages, supergroups only.
+	//
+	// Optional.
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+}
+
+// PromoteChatMember promote or demote a user in a supergroup or a channel.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Pass False for all boolean parameters to demote a user.
+// Returns True on success.
+func (c *Client) PromoteChatMember(ctx context.Context, payload *PromoteChatMemberPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "promoteChatMember", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type SetChatAdministratorCustomTitlePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+
+	// CustomTitle is a new custom title for the administrator; 0-16 characters, emoji are not allowed.
+	CustomTitle string `json:"custom_title"`
+}
+
+// SetChatAdministratorCustomTitle set a custom title for an administrator in a supergroup promoted by the bot.
+// Returns True on success.
+func (c *Client) SetChatAdministratorCustomTitle(ctx context.Context, payload *SetChatAdministratorCustomTitlePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatAdministratorCustomTitle", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type BanChatSenderChatPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// SenderChatID is a unique identifier of the target sender chat.
+	SenderChatID int64 `json:"sender_chat_id"`
+}
+
+// BanChatSenderChat ban a channel chat in a supergroup or a channel.
+// Until the chat is unbanned, the owner of the banned chat won't be able to send messages on behalf of any of their channels.
+// The bot must be an administrator in the supergroup or channel for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) BanChatSenderChat(ctx context.Context, payload *BanChatSenderChatPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "banChatSenderChat", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type UnbanChatSenderChatPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// SenderChatID is a unique identifier of the target sender chat.
+	SenderChatID int64 `json:"sender_chat_id"`
+}
+
+// UnbanChatSenderChat unban a previously banned channel chat in a supergroup or channel.
+// The bot must be an administrator for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) UnbanChatSenderChat(ctx context.Context, payload *UnbanChatSenderChatPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "unbanChatSenderChat", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type SetChatPermissionsPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	ChatID int64 `json:"chat_id"`
+
+	// Permissions is a JSON-serialized object for new default chat permissions.
+	Permissions *ChatPermissions `json:"permissions"`
+}
+
+// SetChatPermissions set default chat permissions for all members.
+// The bot must be an administrator in the group or a supergroup for this to work
+// and must have the CanRestrictMembers administrator rights.
+// Returns True on success.
+func (c *Client) SetChatPermissions(ctx context.Context, payload *SetChatPermissionsPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatPermissions", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type ExportChatInviteLinkPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// ExportChatInviteLink generate a new primary invite link for a chat; any previously generated primary link is revoked.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the new invite link as String on success.
+func (c *Client) ExportChatInviteLink(ctx context.Context, payload *ExportChatInviteLinkPayload) (string, error) {
+	resp, err := c.MakeRequest(ctx, "exportChatInviteLink", payload)
+	if err != nil {
+		return "", err
+	}
+
+	var inviteLink string
+	err = json.Unmarshal(resp.Result, &inviteLink)
+	return inviteLink, err
+}
+
+type CreateChatInviteLinkPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// Name is an invite link name; 0-32 characters.
+	//
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// ExpireDate is a point in time when the link will expire.
+	//
+	// Optional.
+	ExpireDate time.Time `json:"-"`
+
+	// MemberLimit is a maximum number of users that can be members of the chat
+	// simultaneously after joining the chat via this invite link; 1-99999.
+	//
+	// Optional.
+	MemberLimit int `json:"member_limit,omitempty"`
+
+	// CreatesJoinRequest is True, if users joining the chat via the link need to be approved by chat administrators.
+	// If True, MemberLimit can't be specified.
+	//
+	// Optional.
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+// MarshalJSON encodes the payload with ExpireDate in Unix time.
+func (p *CreateChatInviteLinkPayload) MarshalJSON() ([]byte, error) {
+	type alias CreateChatInviteLinkPayload
+	return json.Marshal(&struct {
+		*alias
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{(*alias)(p), unixTime(p.ExpireDate)})
+}
+
+// CreateChatInviteLink create an additional invite link for a chat.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// The link can be revoked using the method RevokeChatInviteLink.
+// Returns the new invite link as ChatInviteLink object.
+func (c *Client) CreateChatInviteLink(ctx context.Context, payload *CreateChatInviteLinkPayload) (*ChatInviteLink, error) {
+	resp, err := c.MakeRequest(ctx, "createChatInviteLink", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var inviteLink ChatInviteLink
+	err = json.Unmarshal(resp.Result, &inviteLink)
+	return &inviteLink, err
+}
+
+type EditChatInviteLinkPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// InviteLink is the invite link to edit.
+	InviteLink string `json:"invite_link"`
+
+	// Name is an invite link name; 0-32 characters.
+	//
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// ExpireDate is a point in time when the link will expire.
+	//
+	// Optional.
+	ExpireDate time.Time `json:"-"`
+
+	// MemberLimit is a maximum number of users that can be members of the chat
+	// simultaneously after joining the chat via this invite link; 1-99999.
+	//
+	// Optional.
+	MemberLimit int `json:"member_limit,omitempty"`
+
+	// CreatesJoinRequest is True, if users joining the chat via the link need to be approved by chat administrators.
+	// If True, MemberLimit can't be specified.
+	//
+	// Optional.
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+// MarshalJSON encodes the payload with ExpireDate in Unix time.
+func (p *EditChatInviteLinkPayload) MarshalJSON() ([]byte, error) {
+	type alias EditChatInviteLinkPayload
+	return json.Marshal(&struct {
+		*alias
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{(*alias)(p), unixTime(p.ExpireDate)})
+}
+
+// EditChatInviteLink edit a non-primary invite link created by the bot.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the edited invite link as a ChatInviteLink object.
+func (c *Client) EditChatInviteLink(ctx context.Context, payload *EditChatInviteLinkPayload) (*ChatInviteLink, error) {
+	resp, err := c.MakeRequest(ctx, "editChatInviteLink", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var inviteLink ChatInviteLink
+	err = json.Unmarshal(resp.Result, &inviteLink)
+	return &inviteLink, err
+}
+
+type RevokeChatInviteLinkPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// InviteLink is the invite link to revoke.
+	InviteLink string `json:"invite_link"`
+}
+
+// RevokeChatInviteLink revoke an invite link created by the bot.
+// If the primary link is revoked, a new link is automatically generated.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the revoked invite link as ChatInviteLink object.
+func (c *Client) RevokeChatInviteLink(ctx context.Context, payload *RevokeChatInviteLinkPayload) (*ChatInviteLink, error) {
+	resp, err := c.MakeRequest(ctx, "revokeChatInviteLink", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var inviteLink ChatInviteLink
+	err = json.Unmarshal(resp.Result, &inviteLink)
+	return &inviteLink, err
+}
+
+type ApproveChatJoinRequestPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+}
+
+// ApproveChatJoinRequest approve a chat join request.
+// The bot must be an administrator in the chat for this to work and must have the CanInviteUsers administrator right.
+// Returns True on success.
+func (c *Client) ApproveChatJoinRequest(ctx context.Context, payload *ApproveChatJoinRequestPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "approveChatJoinRequest", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type DeclineChatJoinRequestPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+}
+
+// DeclineChatJoinRequest decline a chat join request.
+// The bot must be an administrator in the chat for this to work and must have the CanInviteUsers administrator right.
+// Returns True on success.
+func (c *Client) DeclineChatJoinRequest(ctx context.Context, payload *DeclineChatJoinRequestPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "declineChatJoinRequest", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type SetChatPhotoPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// Photo is a new chat photo, uploaded using multipart/form-data.
+	Photo *InputFile `json:"photo"`
+}
+
+func (p *SetChatPhotoPayload) inputFiles() []*InputFile { return []*InputFile{p.Photo} }
+
+// SetChatPhoto set a new profile photo for the chat.
+// Photos can't be changed for private chats.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) SetChatPhoto(ctx context.Context, payload *SetChatPhotoPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatPhoto", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type DeleteChatPhotoPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// DeleteChatPhoto delete a chat photo.
+// Photos can't be changed for private chats.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) DeleteChatPhoto(ctx context.Context, payload *DeleteChatPhotoPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "deleteChatPhoto", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type SetChatTitlePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// Title is a new chat title, 1-255 characters.
+	Title string `json:"title"`
+}
+
+// SetChatTitle change the title of a chat.
+// Titles can't be changed for private chats.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) SetChatTitle(ctx context.Context, payload *SetChatTitlePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatTitle", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type SetChatDescriptionPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// Description is a new chat description, 0-255 characters.
+	//
+	// Optional.
+	Description string `json:"description,omitempty"`
+}
+
+// SetChatDescription change the description of a group, a supergroup or a channel.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func (c *Client) SetChatDescription(ctx context.Context, payload *SetChatDescriptionPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatDescription", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type PinChatMessagePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// MessageID is an identifier of a message to pin.
+	MessageID int64 `json:"message_id"`
+
+	// DisableNotification pass True, if it is not necessary to send a notification to all chat members about the new pinned message.
+	// Notifications are always disabled in channels and private chats.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+}
+
+// PinChatMessage add a message to the list of pinned messages in a chat.
+// If the chat is not a private chat, the bot must be an administrator in the chat
+// and must have the CanPinMessages administrator right in a supergroup or CanEditMessages administrator right in a channel.
+// Returns True on success.
+func (c *Client) PinChatMessage(ctx context.Context, payload *PinChatMessagePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "pinChatMessage", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type UnpinChatMessagePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// MessageID is an identifier of a message to unpin.
+	// If not specified, the most recent pinned message (by sending date) will be unpinned.
+	//
+	// Optional.
+	MessageID int64 `json:"message_id,omitempty"`
+}
+
+// UnpinChatMessage remove a message from the list of pinned messages in a chat.
+// If the chat is not a private chat, the bot must be an administrator in the chat
+// and must have the CanPinMessages administrator right in a supergroup or CanEditMessages administrator right in a channel.
+// Returns True on success.
+func (c *Client) UnpinChatMessage(ctx context.Context, payload *UnpinChatMessagePayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "unpinChatMessage", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type UnpinAllChatMessagesPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+}
+
+// UnpinAllChatMessages clear the list of pinned messages in a chat.
+// If the chat is not a private chat, the bot must be an administrator in the chat
+// and must have the CanPinMessages administrator right in a supergroup or CanEditMessages administrator right in a channel.
+// Returns True on success.
+func (c *Client) UnpinAllChatMessages(ctx context.Context, payload *UnpinAllChatMessagesPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "unpinAllChatMessages", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type LeaveChatPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// LeaveChat leave a group, supergroup or channel.
+// Returns True on success.
+func (c *Client) LeaveChat(ctx context.Context, payload *LeaveChatPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "leaveChat", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type GetChatPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// GetChat get up to date information about the chat
+// (current name of the user for one-on-one conversations, current username of a user, group or channel, etc.).
+// Returns a Chat on success.
+func (c *Client) GetChat(ctx context.Context, payload *GetChatPayload) (*Chat, error) {
+	resp, err := c.MakeRequest(ctx, "getChat", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var chat Chat
+	err = json.Unmarshal(resp.Result, &chat)
+	return &chat, err
+}
+
+type GetChatAdministratorsPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// GetChatAdministrators get a list of administrators in a chat.
+// On success, returns an array of ChatMember that contains information about all chat administrators except other bots.
+// If the chat is a group or a supergroup and no administrators were appointed, only the creator will be returned.
+func (c *Client) GetChatAdministrators(ctx context.Context, payload *GetChatAdministratorsPayload) ([]ChatMember, error) {
+	resp, err := c.MakeRequest(ctx, "getChatAdministrators", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalChatMembers(resp.Result)
+}
+
+type GetChatMemberCountPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// GetChatMemberCount get the number of members in a chat.
+// Returns Int on success.
+func (c *Client) GetChatMemberCount(ctx context.Context, payload *GetChatMemberCountPayload) (int, error) {
+	resp, err := c.MakeRequest(ctx, "getChatMemberCount", payload)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = json.Unmarshal(resp.Result, &count)
+	return count, err
+}
+
+type GetChatMemberPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID int64 `json:"chat_id"`
+
+	// UserID is a unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+}
+
+// GetChatMember get information about a member of a chat.
+// Returns a ChatMember on success.
+func (c *Client) GetChatMember(ctx context.Context, payload *GetChatMemberPayload) (ChatMember, error) {
+	resp, err := c.MakeRequest(ctx, "getChatMember", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalChatMember(resp.Result)
+}
+
+type SetChatStickerSetPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	ChatID int64 `json:"chat_id"`
+
+	// StickerSetName is a name of the sticker set to be set as the group sticker set.
+	StickerSetName string `json:"sticker_set_name"`
+}
+
+// SetChatStickerSet set a new group sticker set for a supergroup.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Use the field CanSetStickerSet optionally returned in GetChat requests to check if the bot can use this method.
+// Returns True on success.
+func (c *Client) SetChatStickerSet(ctx context.Context, payload *SetChatStickerSetPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setChatStickerSet", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type DeleteChatStickerSetPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	ChatID int64 `json:"chat_id"`
+}
+
+// DeleteChatStickerSet delete a group sticker set from a supergroup.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Use the field CanSetStickerSet optionally returned in GetChat requests to check if the bot can use this method.
+// Returns True on success.
+func (c *Client) DeleteChatStickerSet(ctx context.Context, payload *DeleteChatStickerSetPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "deleteChatStickerSet", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+type StopPollPayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// MessageID is an identifier of the original message with the poll.
+	MessageID int64 `json:"message_id"`
+
+	// ReplyMarkup is a new inline keyboard.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopPoll stop a poll which was sent by the bot.
+// On success, the stopped Poll is returned.
+func (c *Client) StopPoll(ctx context.Context, payload *StopPollPayload) (*Poll, error) {
+	resp, err := c.MakeRequest(ctx, "stopPoll", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var poll Poll
+	err = json.Unmarshal(resp.Result, &poll)
+	return &poll, err
+}
+
+// SetPassportDataErrorsPayload represents data for SetPassportDataErrors method.
+type SetPassportDataErrorsPayload struct {
+	// UserID is a user identifier.
+	UserID int64 `json:"user_id"`
+
+	// Errors is a JSON-serialized array describing the errors.
+	Errors []PassportElementError `json:"errors"`
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport elements they provided contains errors.
+// The user will not be able to re-submit their Passport to you until the errors are fixed
+// (the contents of the field for which you returned the error must change).
+// Returns True on success.
+func (c *Client) SetPassportDataErrors(ctx context.Context, payload *SetPassportDataErrorsPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "setPassportDataErrors", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+// SendInvoicePayload represents data for SendInvoice method.
+type SendInvoicePayload struct {
+	// ChatID is a unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID int64 `json:"chat_id"`
+
+	// Title is a product name, 1-32 characters.
+	Title string `json:"title"`
+
+	// Description is a product description, 1-255 characters.
+	Description string `json:"description"`
+
+	// Payload is a bot-defined invoice payload, 1-128 bytes.
+	// This will not be displayed to the user, use for your internal processes.
+	Payload string `json:"payload"`
+
+	// ProviderToken is a payment provider token, obtained via @BotFather.
+	ProviderToken string `json:"provider_token"`
+
+	// Currency is a three-letter ISO 4217 currency code.
+	// See: https://core.telegram.org/bots/payments#supported-currencies
+	Currency string `json:"currency"`
+
+	// Prices is a price breakdown, a list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.).
+	Prices []*LabeledPrice `json:"prices"`
+
+	// MaxTipAmount is the maximum accepted amount for tips in the smallest units of the currency.
+	// For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145.
+	//
+	// Optional.
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+
+	// SuggestedTipAmounts is an array of suggested amounts of tips in the smallest units of the currency.
+	// At most 4 suggested tip amounts can be specified.
+	// The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed MaxTipAmount.
+	//
+	// Optional.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+
+	// StartParameter is a unique deep-linking parameter.
+	// If left empty, forwarded copies of the sent message will have a Pay button, allowing multiple users to pay directly from the forwarded message, using the same invoice.
+	// If non-empty, forwarded copies of the sent message will have a URL button with a deep link to the bot (instead of a Pay button), with the value used as the start parameter.
+	//
+	// Optional.
+	StartParameter string `json:"start_parameter,omitempty"`
+
+	// ProviderData is a JSON-serialized data about the invoice, which will be shared with the payment provider.
+	// A detailed description of required fields should be provided by the payment provider.
+	//
+	// Optional.
+	ProviderData string `json:"provider_data,omitempty"`
+
+	// PhotoURL is a URL of the product photo for the invoice.
+	// Can be a photo of the goods or a marketing image for a service.
+	//
+	// Optional.
+	PhotoURL string `json:"photo_url,omitempty"`
+
+	// PhotoSize is a photo size in bytes.
+	//
+	// Optional.
+	PhotoSize int `json:"photo_size,omitempty"`
+
+	// PhotoWidth is a photo width.
+	//
+	// Optional.
+	PhotoWidth int `json:"photo_width,omitempty"`
+
+	// PhotoHeight is a photo height.
+	//
+	// Optional.
+	PhotoHeight int `json:"photo_height,omitempty"`
+
+	// NeedName pass True, if you require the user's full name to complete the order.
+	//
+	// Optional.
+	NeedName bool `json:"need_name,omitempty"`
+
+	// NeedPhoneNumber pass True, if you require the user's phone number to complete the order.
+	//
+	// Optional.
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+
+	// NeedEmail pass True, if you require the user's email address to complete the order.
+	//
+	// Optional.
+	NeedEmail bool `json:"need_email,omitempty"`
+
+	// NeedShippingAddress pass True, if you require the user's shipping address to complete the order.
+	//
+	// Optional.
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+
+	// SendPhoneNumberToProvider pass True, if the user's phone number should be sent to provider.
+	//
+	// Optional.
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+
+	// SendEmailToProvider pass True, if the user's email address should be sent to provider.
+	//
+	// Optional.
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+
+	// IsFlexible pass True, if the final price depends on the shipping method.
+	//
+	// Optional.
+	IsFlexible bool `json:"is_flexible,omitempty"`
+
+	// DisableNotification sends the message silently.
+	// Users will receive a notification with no sound.
+	//
+	// Optional.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// ProtectContent protects the contents of the sent message from forwarding and saving.
+	//
+	// Optional.
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// ReplyToMessageID is the ID of the original message.
+	//
+	// Optional.
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+
+	// AllowSendingWithoutReply pass True, if the message should be sent even
+	// if the specified replied-to message is not found.
+	//
+	// Optional.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// ReplyMarkup is an inline keyboard.
+	// If empty, one 'Pay total price' button will be shown.
+	// If not empty, the first button must be a Pay button.
+	//
+	// Optional.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendInvoice sending invoices.
+// Returns sent Message on success.
+func (c *Client) SendInvoice(ctx context.Context, payload *SendInvoicePayload) (*Message, error) {
+	resp, err := c.MakeRequest(ctx, "sendInvoice", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	err = json.Unmarshal(resp.Result, &message)
+	return &message, err
+}
+
+// CreateInvoiceLinkPayload represents data for CreateInvoiceLink method.
+type CreateInvoiceLinkPayload struct {
+	// Title is a product name, 1-32 characters.
+	Title string `json:"title"`
+
+	// Description is a product description, 1-255 characters.
+	Description string `json:"description"`
+
+	// Payload is a bot-defined invoice payload, 1-128 bytes.
+	// This will not be displayed to the user, use for your internal processes.
+	Payload string `json:"payload"`
+
+	// ProviderToken is a payment provider token, obtained via @BotFather.
+	ProviderToken string `json:"provider_token"`
+
+	// Currency is a three-letter ISO 4217 currency code.
+	// See: https://core.telegram.org/bots/payments#supported-currencies
+	Currency string `json:"currency"`
+
+	// Prices is a price breakdown, a list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.).
+	Prices []*LabeledPrice `json:"prices"`
+
+	// MaxTipAmount is the maximum accepted amount for tips in the smallest units of the currency.
+	// For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145.
+	//
+	// Optional.
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+
+	// SuggestedTipAmounts is an array of suggested amounts of tips in the smallest units of the currency.
+	// At most 4 suggested tip amounts can be specified.
+	// The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed MaxTipAmount.
+	//
+	// Optional.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+
+	// ProviderData is a JSON-serialized data about the invoice, which will be shared with the payment provider.
+	// A detailed description of required fields should be provided by the payment provider.
+	//
+	// Optional.
+	ProviderData string `json:"provider_data,omitempty"`
+
+	// PhotoURL is a URL of the product photo for the invoice.
+	// Can be a photo of the goods or a marketing image for a service.
+	//
+	// Optional.
+	PhotoURL string `json:"photo_url,omitempty"`
+
+	// PhotoSize is a photo size in bytes.
+	//
+	// Optional.
+	PhotoSize int `json:"photo_size,omitempty"`
+
+	// PhotoWidth is a photo width.
+	//
+	// Optional.
+	PhotoWidth int `json:"photo_width,omitempty"`
+
+	// PhotoHeight is a photo height.
+	//
+	// Optional.
+	PhotoHeight int `json:"photo_height,omitempty"`
+
+	// NeedName pass True, if you require the user's full name to complete the order.
+	//
+	// Optional.
+	NeedName bool `json:"need_name,omitempty"`
+
+	// NeedPhoneNumber pass True, if you require the user's phone number to complete the order.
+	//
+	// Optional.
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+
+	// NeedEmail pass True, if you require the user's email address to complete the order.
+	//
+	// Optional.
+	NeedEmail bool `json:"need_email,omitempty"`
+
+	// NeedShippingAddress pass True, if you require the user's shipping address to complete the order.
+	//
+	// Optional.
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+
+	// SendPhoneNumberToProvider pass True, if the user's phone number should be sent to provider.
+	//
+	// Optional.
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+
+	// SendEmailToProvider pass True, if the user's email address should be sent to provider.
+	//
+	// Optional.
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+
+	// IsFlexible pass True, if the final price depends on the shipping method.
+	//
+	// Optional.
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// CreateInvoiceLink create a link for an invoice.
+// Returns the created invoice link as String on success.
+func (c *Client) CreateInvoiceLink(ctx context.Context, payload *CreateInvoiceLinkPayload) (string, error) {
+	resp, err := c.MakeRequest(ctx, "createInvoiceLink", payload)
+	if err != nil {
+		return "", err
+	}
+
+	var link string
+	err = json.Unmarshal(resp.Result, &link)
+	return link, err
+}
+
+// AnswerShippingQueryPayload represents data for AnswerShippingQuery method.
+type AnswerShippingQueryPayload struct {
+	// ShippingQueryID is a unique identifier for the query to be answered.
+	ShippingQueryID string `json:"shipping_query_id"`
+
+	// OK pass True if delivery to the specified address is possible and False if there are any problems
+	// (for example, if delivery to the specified address is not possible).
+	OK bool `json:"ok"`
+
+	// ShippingOptions is a JSON-serialized array of available shipping options.
+	// Required if OK is True.
+	//
+	// Optional.
+	ShippingOptions []*ShippingOption `json:"shipping_options,omitempty"`
+
+	// ErrorMessage is a message explaining why it is impossible to complete the order
+	// (e.g. "Sorry, delivery to your desired address is unavailable").
+	// Telegram will display this message to the user.
+	// Required if OK is False.
+	//
+	// Optional.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerShippingQuery reply to a shipping query
+// sent as an Update with a ShippingQuery field to an invoice with IsFlexible.
+// Returns True on success.
+func (c *Client) AnswerShippingQuery(ctx context.Context, payload *AnswerShippingQueryPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "answerShippingQuery", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+// AnswerPreCheckoutQueryPayload represents data for AnswerPreCheckoutQuery method.
+type AnswerPreCheckoutQueryPayload struct {
+	// PreCheckoutQueryID is a unique identifier for the query to be answered.
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+
+	// OK pass True if everything is alright (goods are available, etc.) and the bot is ready to proceed with the order.
+	// Pass False if there are any problems.
+	OK bool `json:"ok"`
+
+	// ErrorMessage is a message explaining the reason for failure to proceed with the checkout
+	// (e.g. "Sorry, somebody just bought the last of our amazing black T-shirts while you were busy filling out your payment details.
+	// Please choose a different color or garment!").
+	// Telegram will display this message to the user.
+	// Required if OK is False.
+	//
+	// Optional.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQuery respond to a pre-checkout query
+// sent as an Update with a PreCheckoutQuery field once the user has confirmed their payment and shipping details.
+// The pre-checkout query must be answered within 10 seconds.
+// Returns True on success.
+func (c *Client) AnswerPreCheckoutQuery(ctx context.Context, payload *AnswerPreCheckoutQueryPayload) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "answerPreCheckoutQuery", payload)
+	if err != nil {
+		return false, err
+	}
+
+	var success bool
+	err = json.Unmarshal(resp.Result, &success)
+	return success, err
+}
+
+// unmarshalEditedMessage decodes the result of a message editing method.
+// Telegram returns the edited Message, or True if an inline message was edited.
+func unmarshalEditedMessage(result json.RawMessage) (*EditedMessage, error) {
+	if string(result) == "true" {
+		return &EditedMessage{Inline: true}, nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(result, &message); err != nil {
+		return nil, err
+	}
+
+	return &EditedMessage{Message: &message}, nil
+}
+
+// unixTime returns t as a Unix time, or 0 if t is zero.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
 }
@@ -0,0 +1,165 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// paginatorCallbackPrefix is a prefix of the callback data of Paginator navigation buttons.
+const paginatorCallbackPrefix = "pg:"
+
+// paginatorMaxPageLength is the maximum length of a page number in the callback data of Paginator navigation buttons.
+const paginatorMaxPageLength = 10
+
+// PageSource returns at most limit items of the chat starting from offset, along with the total number of items.
+type PageSource func(ctx context.Context, chatID int64, offset, limit int) (items []interface{}, total int, err error)
+
+// ItemRenderer returns the button of the item shown on a page.
+type ItemRenderer func(item interface{}) *InlineKeyboardButton
+
+// Paginator shows a long list of items as an inline keyboard, a page at a time,
+// with ‹ › buttons to navigate between the pages.
+//
+//	p, err := telegram.NewPaginator(client, "orders", source, func(item interface{}) *telegram.InlineKeyboardButton {
+//		order := item.(*Order)
+//		return telegram.Button.Callback(order.Title, "order:"+order.ID)
+//	})
+//	...
+//	keyboard, err := p.Keyboard(ctx, chatID, 0)
+//	...
+//	client.SendMessage(ctx, &telegram.SendMessagePayload{ChatID: chatID, Text: "Your orders", ReplyMarkup: keyboard})
+//
+// Pass every CallbackQuery to HandleCallbackQuery to navigate between the pages.
+// Pages of inline messages can't be navigated, since the message is shared by the users of a chat
+// while the items are those of a single chat.
+type Paginator struct {
+	client   *Client
+	name     string
+	source   PageSource
+	render   ItemRenderer
+	pageSize int
+}
+
+// PaginatorOption defines an option for a Paginator.
+type PaginatorOption func(*Paginator)
+
+// PaginatorOptionPageSize set the number of items on a page.
+// A size less than 1 is ignored.
+// Defaults to 5.
+func PaginatorOptionPageSize(size int) func(*Paginator) {
+	return func(p *Paginator) {
+		if size > 0 {
+			p.pageSize = size
+		}
+	}
+}
+
+// NewPaginator builds a Paginator of the items from the source rendered by render.
+// The name tells the navigation buttons of paginators apart, so it must be unique and must not contain ':'.
+// The name must be short enough for the callback data of the buttons to fit into MaxCallbackDataLength.
+func NewPaginator(client *Client, name string, source PageSource, render ItemRenderer, options ...PaginatorOption) (*Paginator, error) {
+	if strings.Contains(name, ":") {
+		return nil, fmt.Errorf("paginator %s: name must not contain ':'", name)
+	}
+	if n := len(paginatorCallbackPrefix) + len(name) + 1 + paginatorMaxPageLength; n > MaxCallbackDataLength {
+		return nil, fmt.Errorf("paginator %s: callback data is up to %d bytes, the limit is %d", name, n, MaxCallbackDataLength)
+	}
+
+	p := &Paginator{
+		client:   client,
+		name:     name,
+		source:   source,
+		render:   render,
+		pageSize: 5,
+	}
+
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p, nil
+}
+
+// Keyboard returns the keyboard of the 0-based page of the items of the chat.
+// A page past the end is replaced with the last page.
+func (p *Paginator) Keyboard(ctx context.Context, chatID int64, page int) (*InlineKeyboardMarkup, error) {
+	if page < 0 {
+		page = 0
+	}
+
+	items, total, err := p.source(ctx, chatID, page*p.pageSize, p.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := (total + p.pageSize - 1) / p.pageSize
+	if page >= pages && pages > 0 {
+		page = pages - 1
+		if items, _, err = p.source(ctx, chatID, page*p.pageSize, p.pageSize); err != nil {
+			return nil, err
+		}
+	}
+
+	keyboard := make([][]*InlineKeyboardButton, 0, len(items)+1)
+	for _, item := range items {
+		keyboard = append(keyboard, []*InlineKeyboardButton{p.render(item)})
+	}
+
+	if pages > 1 {
+		var nav []*InlineKeyboardButton
+		if page > 0 {
+			nav = append(nav, Button.Callback("‹", p.callbackData(strconv.Itoa(page-1))))
+		}
+		nav = append(nav, Button.Callback(strconv.Itoa(page+1)+"/"+strconv.Itoa(pages), p.callbackData("")))
+		if page < pages-1 {
+			nav = append(nav, Button.Callback("›", p.callbackData(strconv.Itoa(page+1))))
+		}
+		keyboard = append(keyboard, nav)
+	}
+
+	return &InlineKeyboardMarkup{InlineKeyboard: keyboard}, nil
+}
+
+// HandleCallbackQuery shows the page chosen with a navigation button in place of the current one.
+// Navigation of an inline message is refused with an error.
+// Reports whether the callback query was sent by a navigation button of the paginator.
+func (p *Paginator) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) (bool, error) {
+	prefix := p.callbackData("")
+	if !strings.HasPrefix(query.Data, prefix) {
+		return false, nil
+	}
+
+	if _, err := p.client.AnswerCallbackQuery(ctx, &AnswerCallbackQueryPayload{CallbackQueryID: query.ID}); err != nil {
+		return true, err
+	}
+
+	// The page indicator has been pressed.
+	data := strings.TrimPrefix(query.Data, prefix)
+	if data == "" {
+		return true, nil
+	}
+
+	if query.Message == nil {
+		return true, fmt.Errorf("paginator %s: inline messages can't be navigated", p.name)
+	}
+
+	page, err := strconv.Atoi(data)
+	if err != nil {
+		return true, err
+	}
+
+	payload := &EditMessageReplyMarkupPayload{ChatID: query.Message.Chat.ID, MessageID: query.Message.MessageID}
+	if payload.ReplyMarkup, err = p.Keyboard(ctx, query.Message.Chat.ID, page); err != nil {
+		return true, err
+	}
+
+	_, err = p.client.EditMessageReplyMarkup(ctx, payload)
+	return true, err
+}
+
+// callbackData returns the callback data of a navigation button.
+func (p *Paginator) callbackData(page string) string {
+	return paginatorCallbackPrefix + p.name + ":" + page
+}
@@ -0,0 +1,457 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecryptedPassportData is the PassportData decrypted with the bot's private key.
+type DecryptedPassportData struct {
+	// Elements are the decrypted elements shared with the bot.
+	Elements []*DecryptedPassportElement
+
+	// Nonce is the bot-specified nonce, which should be checked to match the requested one.
+	Nonce string
+}
+
+// DecryptedPassportElement is an EncryptedPassportElement along with its decrypted data and files.
+type DecryptedPassportElement struct {
+	// Element is the encrypted element.
+	Element *EncryptedPassportElement
+
+	// Credentials are the credentials used to decrypt the element.
+	//
+	// Optional.
+	Credentials *SecureValue
+
+	// PersonalDetails are the decrypted data of EncryptedPassportElementTypePersonalDetails.
+	//
+	// Optional.
+	PersonalDetails *PersonalDetails
+
+	// IdDocument is the decrypted data of EncryptedPassportElementTypePassport,
+	// EncryptedPassportElementDriverLicense,
+	// EncryptedPassportElementIdentityCard and EncryptedPassportElementInternalPassport.
+	//
+	// Optional.
+	IdDocument *IdDocumentData
+
+	// Address is the decrypted data of EncryptedPassportElementAddress.
+	//
+	// Optional.
+	Address *ResidentialAddress
+
+	// FrontSide is the decrypted file with the front side of the document.
+	//
+	// Optional.
+	FrontSide []byte
+
+	// ReverseSide is the decrypted file with the reverse side of the document.
+	//
+	// Optional.
+	ReverseSide []byte
+
+	// Selfie is the decrypted file with the selfie of the user holding the document.
+	//
+	// Optional.
+	Selfie []byte
+
+	// Files are the decrypted files with the documents.
+	//
+	// Optional.
+	Files [][]byte
+
+	// Translation are the decrypted files with the translated versions of the documents.
+	//
+	// Optional.
+	Translation [][]byte
+}
+
+// DecryptCredentials decrypts the credentials with the bot's private RSA key.
+func DecryptCredentials(key *rsa.PrivateKey, credentials *EncryptedCredentials) (*Credentials, error) {
+	encryptedSecret, err := base64.StdEncoding.DecodeString(credentials.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("passport credentials: secret: %w", err)
+	}
+
+	secret, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, encryptedSecret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("passport credentials: secret: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(credentials.Data)
+	if err != nil {
+		return nil, fmt.Errorf("passport credentials: data: %w", err)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(credentials.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("passport credentials: hash: %w", err)
+	}
+
+	decrypted, err := decryptPassportData(data, secret, hash)
+	if err != nil {
+		return nil, fmt.Errorf("passport credentials: %w", err)
+	}
+
+	var c Credentials
+	if err := json.Unmarshal(decrypted, &c); err != nil {
+		return nil, fmt.Errorf("passport credentials: %w", err)
+	}
+
+	return &c, nil
+}
+
+// DecryptPassportData decrypts the passport data with the bot's private RSA key,
+// downloading and decrypting the files of every element.
+func (c *Client) DecryptPassportData(ctx context.Context, key *rsa.PrivateKey, data *PassportData) (*DecryptedPassportData, error) {
+	credentials, err := DecryptCredentials(key, data.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := &DecryptedPassportData{Nonce: credentials.Nonce}
+	for _, element := range data.Data {
+		de, err := c.decryptPassportElement(ctx, element, credentials.SecureData.value(element.Type))
+		if err != nil {
+			return nil, fmt.Errorf("passport element %s: %w", element.Type, err)
+		}
+
+		decrypted.Elements = append(decrypted.Elements, de)
+	}
+
+	return decrypted, nil
+}
+
+// decryptPassportElement decrypts the data and files of the element with the credentials.
+// Elements without encrypted data, like EncryptedPassportPhoneNumber, need no credentials.
+func (c *Client) decryptPassportElement(ctx context.Context, element *EncryptedPassportElement, credentials *SecureValue) (*DecryptedPassportElement, error) {
+	de := &DecryptedPassportElement{Element: element, Credentials: credentials}
+	if credentials == nil {
+		return de, nil
+	}
+
+	if element.Data != "" && credentials.Data != nil {
+		var dst interface{}
+		switch element.Type {
+		case EncryptedPassportElementTypePersonalDetails:
+			de.PersonalDetails = &PersonalDetails{}
+			dst = de.PersonalDetails
+		case EncryptedPassportElementTypePassport,
+			EncryptedPassportElementDriverLicense,
+			EncryptedPassportElementIdentityCard,
+			EncryptedPassportElementInternalPassport:
+			de.IdDocument = &IdDocumentData{}
+			dst = de.IdDocument
+		case EncryptedPassportElementAddress:
+			de.Address = &ResidentialAddress{}
+			dst = de.Address
+		}
+
+		if dst != nil {
+			data, err := base64.StdEncoding.DecodeString(element.Data)
+			if err != nil {
+				return nil, fmt.Errorf("data: %w", err)
+			}
+
+			decrypted, err := decryptPassportSecret(data, credentials.Data.Secret, credentials.Data.DataHash)
+			if err != nil {
+				return nil, fmt.Errorf("data: %w", err)
+			}
+
+			if err := json.Unmarshal(decrypted, dst); err != nil {
+				return nil, fmt.Errorf("data: %w", err)
+			}
+		}
+	}
+
+	var err error
+	if de.FrontSide, err = c.decryptPassportFile(ctx, element.FrontSide, credentials.FrontSide); err != nil {
+		return nil, fmt.Errorf("front side: %w", err)
+	}
+	if de.ReverseSide, err = c.decryptPassportFile(ctx, element.ReverseSide, credentials.ReverseSide); err != nil {
+		return nil, fmt.Errorf("reverse side: %w", err)
+	}
+	if de.Selfie, err = c.decryptPassportFile(ctx, element.Selfie, credentials.Selfie); err != nil {
+		return nil, fmt.Errorf("selfie: %w", err)
+	}
+	if de.Files, err = c.decryptPassportFiles(ctx, element.Files, credentials.Files); err != nil {
+		return nil, fmt.Errorf("files: %w", err)
+	}
+	if de.Translation, err = c.decryptPassportFiles(ctx, element.Translation, credentials.Translation); err != nil {
+		return nil, fmt.Errorf("translation: %w", err)
+	}
+
+	return de, nil
+}
+
+// decryptPassportFiles downloads and decrypts the files with the credentials at the same indexes.
+func (c *Client) decryptPassportFiles(ctx context.Context, files []*PassportFile, credentials []*FileCredentials) ([][]byte, error) {
+	if len(files) != len(credentials) {
+		return nil, fmt.Errorf("got %d files and %d credentials", len(files), len(credentials))
+	}
+
+	var decrypted [][]byte
+	for i, file := range files {
+		data, err := c.decryptPassportFile(ctx, file, credentials[i])
+		if err != nil {
+			return nil, fmt.Errorf("file %d: %w", i, err)
+		}
+
+		decrypted = append(decrypted, data)
+	}
+
+	return decrypted, nil
+}
+
+// decryptPassportFile downloads and decrypts the file with the credentials.
+// Returns nil if there is no file.
+func (c *Client) decryptPassportFile(ctx context.Context, file *PassportFile, credentials *FileCredentials) ([]byte, error) {
+	if file == nil {
+		return nil, nil
+	}
+	if credentials == nil {
+		return nil, fmt.Errorf("no credentials")
+	}
+
+	f, err := c.GetFile(ctx, &GetFilePayload{FileID: file.FileID})
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.DownloadFile(ctx, f.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptPassportSecret(data, credentials.Secret, credentials.FileHash)
+}
+
+// decryptPassportSecret decrypts the data with the Base64-encoded secret and hash of credentials.
+func decryptPassportSecret(data []byte, secret, hash string) ([]byte, error) {
+	s, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("secret: %w", err)
+	}
+
+	h, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("hash: %w", err)
+	}
+
+	return decryptPassportData(data, s, h)
+}
+
+// decryptPassportData decrypts the data with AES-256-CBC, using the key and iv derived from the secret and hash,
+// checks the SHA256 of the decrypted data matches the hash and strips the random padding.
+// https://core.telegram.org/passport#decrypting-data
+func decryptPassportData(data, secret, hash []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("data length %d is not a multiple of the block size", len(data))
+	}
+
+	digest := sha512.Sum512(append(secret[:len(secret):len(secret)], hash...))
+	block, err := aes.NewCipher(digest[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, digest[32:48]).CryptBlocks(decrypted, data)
+
+	if sum := sha256.Sum256(decrypted); !bytes.Equal(sum[:], hash) {
+		return nil, fmt.Errorf("hash mismatch")
+	}
+
+	padding := int(decrypted[0])
+	if padding < 32 || padding > len(decrypted) {
+		return nil, fmt.Errorf("invalid padding length %d", padding)
+	}
+
+	return decrypted[padding:], nil
+}
+
+// value returns the credentials of the element type, or nil if there are none.
+func (d *SecureData) value(t EncryptedPassportElementType) *SecureValue {
+	if d == nil {
+		return nil
+	}
+
+	switch t {
+	case EncryptedPassportElementTypePersonalDetails:
+		return d.PersonalDetails
+	case EncryptedPassportElementTypePassport:
+		return d.Passport
+	case EncryptedPassportElementInternalPassport:
+		return d.InternalPassport
+	case EncryptedPassportElementDriverLicense:
+		return d.DriverLicense
+	case EncryptedPassportElementIdentityCard:
+		return d.IdentityCard
+	case EncryptedPassportElementAddress:
+		return d.Address
+	case EncryptedPassportUtilityBill:
+		return d.UtilityBill
+	case EncryptedPassportBankStatement:
+		return d.BankStatement
+	case EncryptedPassportRentalAgreement:
+		return d.RentalAgreement
+	case EncryptedPassportPassportRegistration:
+		return d.PassportRegistration
+	case EncryptedPassportTemporaryRegistration:
+		return d.TemporaryRegistration
+	}
+
+	return nil
+}
+
+// DataFieldError returns an error in the data field of the element, resolved when the field's value changes.
+func (e *DecryptedPassportElement) DataFieldError(fieldName, message string) (*PassportElementErrorDataField, error) {
+	if e.Credentials == nil || e.Credentials.Data == nil {
+		return nil, fmt.Errorf("passport element %s has no data", e.Element.Type)
+	}
+
+	return &PassportElementErrorDataField{
+		Source:    PassportElementErrorSourceData,
+		Type:      e.Element.Type,
+		FieldName: fieldName,
+		DataHash:  e.Credentials.Data.DataHash,
+		Message:   message,
+	}, nil
+}
+
+// FrontSideError returns an error in the front side of the document, resolved when the file changes.
+func (e *DecryptedPassportElement) FrontSideError(message string) (*PassportElementErrorFrontSide, error) {
+	if e.Credentials == nil || e.Credentials.FrontSide == nil {
+		return nil, fmt.Errorf("passport element %s has no front side", e.Element.Type)
+	}
+
+	return &PassportElementErrorFrontSide{
+		Source:   PassportElementErrorSourceFrontSide,
+		Type:     e.Element.Type,
+		FileHash: e.Credentials.FrontSide.FileHash,
+		Message:  message,
+	}, nil
+}
+
+// ReverseSideError returns an error in the reverse side of the document, resolved when the file changes.
+func (e *DecryptedPassportElement) ReverseSideError(message string) (*PassportElementErrorReverseSide, error) {
+	if e.Credentials == nil || e.Credentials.ReverseSide == nil {
+		return nil, fmt.Errorf("passport element %s has no reverse side", e.Element.Type)
+	}
+
+	return &PassportElementErrorReverseSide{
+		Source:   PassportElementErrorSourceReverseSide,
+		Type:     e.Element.Type,
+		FileHash: e.Credentials.ReverseSide.FileHash,
+		Message:  message,
+	}, nil
+}
+
+// SelfieError returns an error in the selfie with the document, resolved when the file changes.
+func (e *DecryptedPassportElement) SelfieError(message string) (*PassportElementErrorSelfie, error) {
+	if e.Credentials == nil || e.Credentials.Selfie == nil {
+		return nil, fmt.Errorf("passport element %s has no selfie", e.Element.Type)
+	}
+
+	return &PassportElementErrorSelfie{
+		Source:   PassportElementErrorSourceSelfie,
+		Type:     e.Element.Type,
+		FileHash: e.Credentials.Selfie.FileHash,
+		Message:  message,
+	}, nil
+}
+
+// FileError returns an error in the i-th document scan, resolved when the file changes.
+func (e *DecryptedPassportElement) FileError(i int, message string) (*PassportElementErrorFile, error) {
+	if e.Credentials == nil || i < 0 || i >= len(e.Credentials.Files) {
+		return nil, fmt.Errorf("passport element %s has no file %d", e.Element.Type, i)
+	}
+
+	return &PassportElementErrorFile{
+		Source:   PassportElementErrorSourceFile,
+		Type:     e.Element.Type,
+		FileHash: e.Credentials.Files[i].FileHash,
+		Message:  message,
+	}, nil
+}
+
+// FilesError returns an error in the list of document scans, resolved when the list of files changes.
+func (e *DecryptedPassportElement) FilesError(message string) (*PassportElementErrorFiles, error) {
+	if e.Credentials == nil || len(e.Credentials.Files) == 0 {
+		return nil, fmt.Errorf("passport element %s has no files", e.Element.Type)
+	}
+
+	return &PassportElementErrorFiles{
+		Source:     PassportElementErrorSourceFiles,
+		Type:       e.Element.Type,
+		FileHashes: fileHashes(e.Credentials.Files),
+		Message:    message,
+	}, nil
+}
+
+// TranslationFileError returns an error in the i-th translated document, resolved when the file changes.
+func (e *DecryptedPassportElement) TranslationFileError(i int, message string) (*PassportElementErrorTranslationFile, error) {
+	if e.Credentials == nil || i < 0 || i >= len(e.Credentials.Translation) {
+		return nil, fmt.Errorf("passport element %s has no translation file %d", e.Element.Type, i)
+	}
+
+	return &PassportElementErrorTranslationFile{
+		Source:   PassportElementErrorSourceTranslationFile,
+		Type:     e.Element.Type,
+		FileHash: e.Credentials.Translation[i].FileHash,
+		Message:  message,
+	}, nil
+}
+
+// TranslationFilesError returns an error in the translated versions of the document,
+// resolved when the list of files changes.
+func (e *DecryptedPassportElement) TranslationFilesError(message string) (*PassportElementErrorTranslationFiles, error) {
+	if e.Credentials == nil || len(e.Credentials.Translation) == 0 {
+		return nil, fmt.Errorf("passport element %s has no translation files", e.Element.Type)
+	}
+
+	return &PassportElementErrorTranslationFiles{
+		Source:     PassportElementErrorSourceTranslationFiles,
+		Type:       e.Element.Type,
+		FileHashes: fileHashes(e.Credentials.Translation),
+		Message:    message,
+	}, nil
+}
+
+// UnspecifiedError returns an error in an unspecified place of the element, resolved when new data is added.
+func (e *DecryptedPassportElement) UnspecifiedError(message string) *PassportElementErrorUnspecified {
+	return &PassportElementErrorUnspecified{
+		Source:      PassportElementErrorSourceUnspecified,
+		Type:        e.Element.Type,
+		ElementHash: e.Element.Hash,
+		Message:     message,
+	}
+}
+
+// fileHashes returns the hashes of the files with the credentials.
+func fileHashes(credentials []*FileCredentials) []string {
+	hashes := make([]string, 0, len(credentials))
+	for _, c := range credentials {
+		hashes = append(hashes, c.FileHash)
+	}
+
+	return hashes
+}
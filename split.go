@@ -0,0 +1,143 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// MaxMessageTextLength is the maximum length of a message text after entities parsing, in UTF-16 code units.
+	MaxMessageTextLength = 4096
+
+	// MaxCaptionLength is the maximum length of a media caption after entities parsing, in UTF-16 code units.
+	MaxCaptionLength = 1024
+)
+
+// TextChunk is a part of a text split by SplitText along with the entities of the part.
+type TextChunk struct {
+	Text     string
+	Entities []*MessageEntity
+}
+
+// SplitText splits the text into chunks of at most limit UTF-16 code units.
+// The text is split at a paragraph, a line or a word boundary, preferably outside any entity.
+// An entity that can't be kept whole is split between the chunks.
+// Chunks consisting of whitespace only are dropped.
+// The limit must be at least 2 to fit any code point.
+func SplitText(text string, entities []*MessageEntity, limit int) ([]*TextChunk, error) {
+	if limit < 2 {
+		return nil, fmt.Errorf("split text: limit must be at least 2, got %d", limit)
+	}
+
+	units := utf16.Encode([]rune(text))
+
+	var chunks []*TextChunk
+	for start := 0; start < len(units); {
+		end := len(units)
+		if end-start > limit {
+			end = splitPoint(units, entities, start, start+limit)
+		}
+
+		chunk := &TextChunk{Text: string(utf16.Decode(units[start:end]))}
+		for _, entity := range entities {
+			from, to := entity.Offset, entity.Offset+entity.Length
+			if from < start {
+				from = start
+			}
+			if to > end {
+				to = end
+			}
+			if from >= to {
+				continue
+			}
+
+			e := *entity
+			e.Offset, e.Length = from-start, to-from
+			chunk.Entities = append(chunk.Entities, &e)
+		}
+
+		if strings.TrimSpace(chunk.Text) != "" {
+			chunks = append(chunks, chunk)
+		}
+		start = end
+	}
+
+	return chunks, nil
+}
+
+// splitPoint returns the end of a chunk starting at start and ending no later than limit.
+func splitPoint(units []uint16, entities []*MessageEntity, start, limit int) int {
+	boundaries := []func(p int) bool{
+		// Paragraph.
+		func(p int) bool { return p-2 >= start && units[p-1] == '\n' && units[p-2] == '\n' },
+		// Line.
+		func(p int) bool { return units[p-1] == '\n' },
+		// Word.
+		func(p int) bool { return units[p-1] == ' ' || units[p-1] == '\t' },
+		// Anything but the middle of a surrogate pair.
+		func(p int) bool { return units[p] < 0xdc00 || units[p] > 0xdfff },
+	}
+
+	inside := func(p int) bool {
+		for _, entity := range entities {
+			if entity.Offset < p && p < entity.Offset+entity.Length {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, keepEntities := range []bool{true, false} {
+		for _, boundary := range boundaries {
+			for p := limit; p > start; p-- {
+				if boundary(p) && !(keepEntities && inside(p)) {
+					return p
+				}
+			}
+		}
+	}
+
+	// A limit of at least 2 always fits a whole code point, so this is never reached.
+	if units[start] >= 0xd800 && units[start] < 0xdc00 {
+		return start + 2
+	}
+	return start + 1
+}
+
+// SendLongMessage sends the text of the payload split by SplitText into messages of at most MaxMessageTextLength.
+// Only the first message replies to ReplyToMessageID and only the last one has ReplyMarkup.
+// The text must be formatted with Entities, since a ParseMode markup can't be split safely.
+// Returns the sent messages on success, or the messages sent before the error otherwise.
+func (c *Client) SendLongMessage(ctx context.Context, payload *SendMessagePayload) ([]*Message, error) {
+	if payload.ParseMode != "" {
+		return nil, fmt.Errorf("long message: parse mode %q is not supported, use entities instead", payload.ParseMode)
+	}
+
+	chunks, err := SplitText(payload.Text, payload.Entities, MaxMessageTextLength)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		part := *payload
+		part.Text, part.Entities = chunk.Text, chunk.Entities
+		if i > 0 {
+			part.ReplyToMessageID = 0
+			part.AllowSendingWithoutReply = false
+		}
+		if i < len(chunks)-1 {
+			part.ReplyMarkup = nil
+		}
+
+		message, err := c.SendMessage(ctx, &part)
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,103 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by a Storage for a key without a value.
+var ErrNotFound = errors.New("not found")
+
+// Storage is a key-value store for the state kept on the bot's side, like callback data too long for a button.
+type Storage interface {
+	// Get returns the value of the key, or ErrNotFound if there is none or it has expired.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Set stores the value of the key, expiring after ttl.
+	// If ttl is 0, the value never expires and is kept permanently until it's deleted.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the value of the key.
+	Delete(ctx context.Context, key string) error
+}
+
+// memoryStorageSweepInterval is the minimum time between two sweeps of expired values of a MemoryStorage.
+const memoryStorageSweepInterval = time.Minute
+
+// MemoryStorage is a Storage keeping values in memory.
+// Expired values are swept on Set, at most once per minute,
+// while values stored with a ttl of 0 are kept until they're deleted.
+type MemoryStorage struct {
+	mu        sync.Mutex
+	values    map[string]memoryValue
+	lastSweep time.Time
+}
+
+// memoryValue is a value of a MemoryStorage.
+type memoryValue struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryStorage builds an empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{values: make(map[string]memoryValue)}
+}
+
+// Get returns the value of the key, or ErrNotFound.
+func (s *MemoryStorage) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if !v.expires.IsZero() && time.Now().After(v.expires) {
+		delete(s.values, key)
+		return nil, ErrNotFound
+	}
+
+	return append([]byte(nil), v.value...), nil
+}
+
+// Set stores the value of the key.
+func (s *MemoryStorage) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) >= memoryStorageSweepInterval {
+		s.sweep(now)
+	}
+
+	v := memoryValue{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		v.expires = now.Add(ttl)
+	}
+
+	s.values[key] = v
+	return nil
+}
+
+// sweep removes the values expired by now.
+func (s *MemoryStorage) sweep(now time.Time) {
+	for key, v := range s.values {
+		if !v.expires.IsZero() && now.After(v.expires) {
+			delete(s.values, key)
+		}
+	}
+
+	s.lastSweep = now
+}
+
+// Delete removes the value of the key.
+func (s *MemoryStorage) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.values, key)
+	return nil
+}
@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"strconv"
 )
 
 const (
@@ -64,12 +67,48 @@ func New(token string, options ...Option) *Client {
 	return s
 }
 
+// Migrate moves the bot to the Bot API server with the apiEndpoint and fileEndpoint,
+// and returns a client for it.
+// Endpoints have the same format as APIEndpoint and FileEndpoint.
+//
+// A bot running on the cloud Bot API server is logged out.
+// A bot running on a local Bot API server has its webhook deleted and is closed.
+// Note that a bot logged out from the cloud can't log in back to it for 10 minutes,
+// and a bot can't be closed in the first 10 minutes after it's launched.
+func (c *Client) Migrate(ctx context.Context, apiEndpoint, fileEndpoint string) (*Client, error) {
+	if c.apiEndpoint == APIEndpoint {
+		if _, err := c.LogOut(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := c.MakeRequest(ctx, "deleteWebhook", nil); err != nil {
+			return nil, err
+		}
+
+		if _, err := c.Close(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return New(c.token, OptionHTTPClient(c.httpclient), OptionAPIURL(apiEndpoint), OptionFileURL(fileEndpoint)), nil
+}
+
 // MakeRequest makes a request to a specific endpoint with our token.
 func (c *Client) MakeRequest(ctx context.Context, method string, body interface{}) (*APIResponse, error) {
 	endpoint := fmt.Sprintf(c.apiEndpoint, c.token, method)
 
-	buf := new(bytes.Buffer)
-	if err := json.NewEncoder(buf).Encode(body); err != nil {
+	var (
+		buf         = new(bytes.Buffer)
+		contentType = "application/json"
+	)
+
+	if u, ok := body.(uploader); ok && hasUploads(u.inputFiles()) {
+		ct, err := encodeMultipart(buf, body, u.inputFiles())
+		if err != nil {
+			return nil, err
+		}
+		contentType = ct
+	} else if err := json.NewEncoder(buf).Encode(body); err != nil {
 		return nil, err
 	}
 
@@ -77,7 +116,7 @@ func (c *Client) MakeRequest(ctx context.Context, method string, body interface{
 	if err != nil {
 		return &APIResponse{}, err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	resp, err := c.httpclient.Do(req)
 	if err != nil {
@@ -100,3 +139,100 @@ func (c *Client) MakeRequest(ctx context.Context, method string, body interface{
 
 	return &apiResp, nil
 }
+
+// DownloadFile downloads the file at the path returned by GetFile from the file endpoint.
+// The caller must close the returned reader.
+func (c *Client) DownloadFile(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(c.fileEndpoint, c.token, filePath), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpclient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("download file %s: %s", filePath, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// uploader is implemented by payloads which may contain files to be uploaded.
+type uploader interface {
+	inputFiles() []*InputFile
+}
+
+// hasUploads reports whether any of files has to be uploaded.
+func hasUploads(files []*InputFile) bool {
+	for _, f := range files {
+		if f != nil && f.Reader != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeMultipart writes body as a multipart/form-data request into buf.
+// Every top-level field of body becomes a form field,
+// and every uploaded file becomes a form file referenced by an attach://<name> value.
+// It returns the Content-Type of the request.
+func encodeMultipart(buf *bytes.Buffer, body interface{}, files []*InputFile) (string, error) {
+	n := 0
+	for _, f := range files {
+		if f != nil && f.Reader != nil {
+			f.attach = "file" + strconv.Itoa(n)
+			n++
+		}
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", err
+	}
+
+	w := multipart.NewWriter(buf)
+	for name, value := range fields {
+		if string(value) == "null" {
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			s = string(value)
+		}
+
+		if err := w.WriteField(name, s); err != nil {
+			return "", err
+		}
+	}
+
+	for _, f := range files {
+		if f == nil || f.Reader == nil {
+			continue
+		}
+
+		part, err := w.CreateFormFile(f.attach, f.Name)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := io.Copy(part, f.Reader); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return w.FormDataContentType(), nil
+}
@@ -2,7 +2,11 @@
 
 package telegram
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
 
 // APIResponse is a response from the Telegram API with the result
 // stored raw.
@@ -314,7 +318,7 @@ type Chat struct {
 	// Returned only in api.GetChat().
 	//
 	// Optional.
-	HasProtectedContent int `json:"has_protected_content,omitempty"`
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
 
 	// StickerSetName ia a name of group sticker set.
 	// Returned only in api.GetChat().
@@ -409,7 +413,7 @@ type Message struct {
 	// that was automatically forwarded to the connected discussion group.
 	//
 	// Optional.
-	IsAutomaticForward int `json:"is_automatic_forward,omitempty"`
+	IsAutomaticForward bool `json:"is_automatic_forward,omitempty"`
 
 	// ReplyToMessage for replies, the original message.
 	// Note that the Message object in this field will not contain further reply_to_message fields
@@ -660,6 +664,16 @@ type Message struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// EditedMessage is the result of a message editing method.
+type EditedMessage struct {
+	// Message is the edited message.
+	// Nil if an inline message was edited, for which Telegram returns only True.
+	Message *Message
+
+	// Inline is True, if an inline message was edited.
+	Inline bool
+}
+
 // MessageID represents a unique message identifier.
 type MessageID struct {
 	// MessageID is a unique message identifier.
@@ -999,6 +1013,15 @@ type Dice struct {
 	Value int `json:"value"`
 }
 
+const (
+	DiceEmojiDice        = "🎲"
+	DiceEmojiDarts       = "🎯"
+	DiceEmojiBowling     = "🎳"
+	DiceEmojiBasketball  = "🏀"
+	DiceEmojiFootball    = "⚽"
+	DiceEmojiSlotMachine = "🎰"
+)
+
 // PollOption contains information about one answer option in a poll.
 type PollOption struct {
 	// Text is an option text, 1-100 characters.
@@ -1244,6 +1267,22 @@ type WebAppInfo struct {
 	URL string `json:"url"`
 }
 
+// ReplyMarkup is an additional interface option of a sent message.
+// Currently, the following 4 types of reply markup are supported:
+// *InlineKeyboardMarkup,
+// *ReplyKeyboardMarkup,
+// *ReplyKeyboardRemove,
+// *ForceReply.
+type ReplyMarkup interface {
+	// replyMarkup restricts implementations to the types of this package.
+	replyMarkup()
+}
+
+func (*InlineKeyboardMarkup) replyMarkup() {}
+func (*ReplyKeyboardMarkup) replyMarkup()  {}
+func (*ReplyKeyboardRemove) replyMarkup()  {}
+func (*ForceReply) replyMarkup()           {}
+
 // ReplyKeyboardMarkup is a custom keyboard with reply options (see Introduction to bots for details and examples).
 type ReplyKeyboardMarkup struct {
 	// Keyboard is an array of button rows, each represented by an Array of KeyboardButton objects.
@@ -1307,7 +1346,7 @@ type KeyboardButton struct {
 	// Available in ChatTypePrivate.
 	//
 	// Optional.
-	WebApp *WebAppInfo `json:"web_app"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
 // KeyboardButtonPollType is a type of poll,
@@ -1372,21 +1411,21 @@ type InlineKeyboardButton struct {
 	// Available only in ChatTypePrivate between a user and the bot.
 	//
 	// Optional.
-	WebApp *WebAppInfo `json:"web_app"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 
 	// SwitchInlineQuery if set, pressing the button will prompt the user to select one of their chats,
 	// open that chat and insert the bot's username and the specified inline query in the input field.
 	// Can be empty, in which case just the bot's username will be inserted.
 	//
 	// Optional.
-	SwitchInlineQuery string `json:"switch_inline_query,omitempty"`
+	SwitchInlineQuery *string `json:"switch_inline_query,omitempty"`
 
 	// SwitchInlineQueryCurrentChat if set, pressing the button will insert the bot's username
 	// and the specified inline query in the current chat's input field.
 	// Can be empty, in which case only the bot's username will be inserted.
 	//
 	// Optional.
-	SwitchInlineQueryCurrentChat string `json:"switch_inline_query_current_chat,omitempty"`
+	SwitchInlineQueryCurrentChat *string `json:"switch_inline_query_current_chat,omitempty"`
 
 	// CallbackGame is a description of the game that will be launched when the user presses the button.
 	//
@@ -1617,20 +1656,16 @@ const (
 
 // ChatMember is an information about one member of a chat.
 // Currently, the following 6 types of chat members are supported:
-// ChatMemberOwner,
-// ChatMemberAdministrator,
-// ChatMemberMember,
-// ChatMemberRestricted,
-// ChatMemberLeft,
-// ChatMemberBanned.
-type ChatMember struct {
-	ChatMemberOwner
-	ChatMemberAdministrator
-	ChatMemberMember
-	ChatMemberRestricted
-	ChatMemberLeft
-	ChatMemberBanned
-	ChatMemberUpdated
+// *ChatMemberOwner,
+// *ChatMemberAdministrator,
+// *ChatMemberMember,
+// *ChatMemberRestricted,
+// *ChatMemberLeft,
+// *ChatMemberBanned.
+// Use a type switch to get the member's information.
+type ChatMember interface {
+	// chatMember restricts implementations to the types of this package.
+	chatMember()
 }
 
 // ChatMemberOwner is a chat member that owns the chat and has all administrator privileges.
@@ -1779,6 +1814,63 @@ type ChatMemberBanned struct {
 	UntilDate int `json:"until_date"`
 }
 
+func (*ChatMemberOwner) chatMember()         {}
+func (*ChatMemberAdministrator) chatMember() {}
+func (*ChatMemberMember) chatMember()        {}
+func (*ChatMemberRestricted) chatMember()    {}
+func (*ChatMemberLeft) chatMember()          {}
+func (*ChatMemberBanned) chatMember()        {}
+
+// unmarshalChatMember decodes a ChatMember of the type specified by its status.
+func unmarshalChatMember(data []byte) (ChatMember, error) {
+	var probe struct {
+		Status ChatMemberStatus `json:"status"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+
+	var member ChatMember
+	switch probe.Status {
+	case ChatMemberStatusCreator:
+		member = &ChatMemberOwner{}
+	case ChatMemberStatusAdministrator:
+		member = &ChatMemberAdministrator{}
+	case ChatMemberStatusMember:
+		member = &ChatMemberMember{}
+	case ChatMemberStatusRestricted:
+		member = &ChatMemberRestricted{}
+	case ChatMemberStatusLeft:
+		member = &ChatMemberLeft{}
+	case ChatMemberStatusKicked:
+		member = &ChatMemberBanned{}
+	default:
+		return nil, fmt.Errorf("unknown chat member status %q", probe.Status)
+	}
+
+	err := json.Unmarshal(data, member)
+	return member, err
+}
+
+// unmarshalChatMembers decodes an array of ChatMember.
+func unmarshalChatMembers(data []byte) ([]ChatMember, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	members := make([]ChatMember, 0, len(raw))
+	for _, r := range raw {
+		member, err := unmarshalChatMember(r)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
 // ChatMemberUpdated is a changes in the status of a chat member.
 type ChatMemberUpdated struct {
 	// Chat the user belongs to.
@@ -1791,10 +1883,10 @@ type ChatMemberUpdated struct {
 	Date int `json:"date"`
 
 	// OldChatMember is previous information about the chat member.
-	OldChatMember *ChatMember `json:"old_chat_member"`
+	OldChatMember ChatMember `json:"old_chat_member"`
 
 	// NewChatMember is new information about the chat member.
-	NewChatMember *ChatMember `json:"new_chat_member"`
+	NewChatMember ChatMember `json:"new_chat_member"`
 
 	// InviteLink is a chat invite link, which was used by the user to join the chat.
 	// For joining by invite link events only.
@@ -1803,13 +1895,34 @@ type ChatMemberUpdated struct {
 	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
 }
 
+// UnmarshalJSON decodes the update along with the polymorphic ChatMember fields.
+func (u *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	type alias ChatMemberUpdated
+	aux := struct {
+		*alias
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{alias: (*alias)(u)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if u.OldChatMember, err = unmarshalChatMember(aux.OldChatMember); err != nil {
+		return err
+	}
+
+	u.NewChatMember, err = unmarshalChatMember(aux.NewChatMember)
+	return err
+}
+
 // ChatJoinRequest is a join request sent to a chat.
 type ChatJoinRequest struct {
 	// Chat to which the request was sent.
 	Chat *Chat `json:"chat"`
 
-	// User that sent the join request.
-	User *User `json:"user"`
+	// From is a user that sent the join request.
+	From *User `json:"from"`
 
 	// Date the request was sent in Unix time.
 	Date int `json:"date"`
@@ -1877,7 +1990,7 @@ type ChatPermissions struct {
 type ChatLocation struct {
 	// Location is the location to which the supergroup is connected.
 	// Can't be a live location.
-	Location *Location
+	Location *Location `json:"location"`
 
 	// Address is the location address, defined by the chat owner.
 	Address string `json:"address"`
@@ -1907,21 +2020,16 @@ const (
 
 // BotCommandScope is the scope to which bot commands are applied.
 // Currently, the following 7 scopes are supported:
-// BotCommandScopeDefault,
-// BotCommandScopeAllPrivateChats,
-// BotCommandScopeAllGroupChats,
-// BotCommandScopeAllChatAdministrators,
-// BotCommandScopeChat,
-// BotCommandScopeChatAdministrators,
-// BotCommandScopeChatMember.
-type BotCommandScope struct {
-	BotCommandScopeDefault
-	BotCommandScopeAllPrivateChats
-	BotCommandScopeAllGroupChats
-	BotCommandScopeAllChatAdministrators
-	BotCommandScopeChat
-	BotCommandScopeChatAdministrators
-	BotCommandScopeChatMember
+// *BotCommandScopeDefault,
+// *BotCommandScopeAllPrivateChats,
+// *BotCommandScopeAllGroupChats,
+// *BotCommandScopeAllChatAdministrators,
+// *BotCommandScopeChat,
+// *BotCommandScopeChatAdministrators,
+// *BotCommandScopeChatMember.
+type BotCommandScope interface {
+	// botCommandScope restricts implementations to the types of this package.
+	botCommandScope()
 }
 
 // BotCommandScopeDefault is the default scope of bot commands.
@@ -1976,13 +2084,21 @@ type BotCommandScopeChatMember struct {
 	// Type is a scope type, must be BotCommandScopeTypeChatMember.
 	Type BotCommandScopeType `json:"type"`
 
-	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
+	// ChatID is a unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername).
 	ChatID int64 `json:"chat_id"`
 
-	// ChatID is a unique identifier of the target user.
+	// UserID is a unique identifier of the target user.
 	UserID int64 `json:"user_id"`
 }
 
+func (*BotCommandScopeDefault) botCommandScope()               {}
+func (*BotCommandScopeAllPrivateChats) botCommandScope()       {}
+func (*BotCommandScopeAllGroupChats) botCommandScope()         {}
+func (*BotCommandScopeAllChatAdministrators) botCommandScope() {}
+func (*BotCommandScopeChat) botCommandScope()                  {}
+func (*BotCommandScopeChatAdministrators) botCommandScope()    {}
+func (*BotCommandScopeChatMember) botCommandScope()            {}
+
 type MenuButtonType string
 
 const (
@@ -2037,6 +2153,24 @@ type MenuButtonDefault struct {
 	Type MenuButtonType `json:"type"`
 }
 
+// BotName is the bot's name.
+type BotName struct {
+	// Name is the bot's name.
+	Name string `json:"name"`
+}
+
+// BotDescription is the bot's description.
+type BotDescription struct {
+	// Description is the bot's description.
+	Description string `json:"description"`
+}
+
+// BotShortDescription is the bot's short description.
+type BotShortDescription struct {
+	// ShortDescription is the bot's short description.
+	ShortDescription string `json:"short_description"`
+}
+
 // ResponseParameters are various errors that can be returned in APIResponse.
 type ResponseParameters struct {
 	// The group has been migrated to a supergroup with the specified identifier.
@@ -2051,6 +2185,334 @@ type ResponseParameters struct {
 	RetryAfter int `json:"retry_after,omitempty"`
 }
 
+type InputMediaType string
+
+const (
+	InputMediaTypePhoto     InputMediaType = "photo"
+	InputMediaTypeVideo     InputMediaType = "video"
+	InputMediaTypeAnimation InputMediaType = "animation"
+	InputMediaTypeAudio     InputMediaType = "audio"
+	InputMediaTypeDocument  InputMediaType = "document"
+)
+
+// InputMedia is the content of a media message to be sent.
+// It should be one of:
+// InputMediaAnimation,
+// InputMediaDocument,
+// InputMediaAudio,
+// InputMediaPhoto,
+// InputMediaVideo.
+type InputMedia interface {
+	uploader
+
+	// inputMediaType returns the type of the media.
+	inputMediaType() InputMediaType
+}
+
+// InputMediaPhoto is a photo to be sent.
+type InputMediaPhoto struct {
+	// Media is a file to send.
+	Media *InputFile `json:"media"`
+
+	// Caption of the photo to be sent, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// ParseMode is a mode for parsing entities in the photo caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+}
+
+func (m *InputMediaPhoto) inputMediaType() InputMediaType { return InputMediaTypePhoto }
+
+func (m *InputMediaPhoto) inputFiles() []*InputFile { return []*InputFile{m.Media} }
+
+// MarshalJSON encodes the photo along with its type.
+func (m *InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputMediaPhoto
+	return json.Marshal(&struct {
+		Type InputMediaType `json:"type"`
+		*alias
+	}{InputMediaTypePhoto, (*alias)(m)})
+}
+
+// InputMediaVideo is a video to be sent.
+type InputMediaVideo struct {
+	// Media is a file to send.
+	Media *InputFile `json:"media"`
+
+	// Thumb is a thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file.
+	//
+	// Optional.
+	Thumb *InputFile `json:"thumb,omitempty"`
+
+	// Caption of the video to be sent, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// ParseMode is a mode for parsing entities in the video caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// Width is a video width.
+	//
+	// Optional.
+	Width int `json:"width,omitempty"`
+
+	// Height is a video height.
+	//
+	// Optional.
+	Height int `json:"height,omitempty"`
+
+	// Duration is a video duration in seconds.
+	//
+	// Optional.
+	Duration int `json:"duration,omitempty"`
+
+	// SupportsStreaming pass True, if the uploaded video is suitable for streaming.
+	//
+	// Optional.
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+}
+
+func (m *InputMediaVideo) inputMediaType() InputMediaType { return InputMediaTypeVideo }
+
+func (m *InputMediaVideo) inputFiles() []*InputFile { return []*InputFile{m.Media, m.Thumb} }
+
+// MarshalJSON encodes the video along with its type.
+func (m *InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputMediaVideo
+	return json.Marshal(&struct {
+		Type InputMediaType `json:"type"`
+		*alias
+	}{InputMediaTypeVideo, (*alias)(m)})
+}
+
+// InputMediaAnimation is an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.
+type InputMediaAnimation struct {
+	// Media is a file to send.
+	Media *InputFile `json:"media"`
+
+	// Thumb is a thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file.
+	//
+	// Optional.
+	Thumb *InputFile `json:"thumb,omitempty"`
+
+	// Caption of the animation to be sent, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// ParseMode is a mode for parsing entities in the animation caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// Width is an animation width.
+	//
+	// Optional.
+	Width int `json:"width,omitempty"`
+
+	// Height is an animation height.
+	//
+	// Optional.
+	Height int `json:"height,omitempty"`
+
+	// Duration is an animation duration in seconds.
+	//
+	// Optional.
+	Duration int `json:"duration,omitempty"`
+}
+
+func (m *InputMediaAnimation) inputMediaType() InputMediaType { return InputMediaTypeAnimation }
+
+func (m *InputMediaAnimation) inputFiles() []*InputFile { return []*InputFile{m.Media, m.Thumb} }
+
+// MarshalJSON encodes the animation along with its type.
+func (m *InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAnimation
+	return json.Marshal(&struct {
+		Type InputMediaType `json:"type"`
+		*alias
+	}{InputMediaTypeAnimation, (*alias)(m)})
+}
+
+// InputMediaAudio is an audio file to be treated as music to be sent.
+type InputMediaAudio struct {
+	// Media is a file to send.
+	Media *InputFile `json:"media"`
+
+	// Thumb is a thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file.
+	//
+	// Optional.
+	Thumb *InputFile `json:"thumb,omitempty"`
+
+	// Caption of the audio to be sent, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// ParseMode is a mode for parsing entities in the audio caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// Duration of the audio in seconds.
+	//
+	// Optional.
+	Duration int `json:"duration,omitempty"`
+
+	// Performer of the audio.
+	//
+	// Optional.
+	Performer string `json:"performer,omitempty"`
+
+	// Title of the audio.
+	//
+	// Optional.
+	Title string `json:"title,omitempty"`
+}
+
+func (m *InputMediaAudio) inputMediaType() InputMediaType { return InputMediaTypeAudio }
+
+func (m *InputMediaAudio) inputFiles() []*InputFile { return []*InputFile{m.Media, m.Thumb} }
+
+// MarshalJSON encodes the audio along with its type.
+func (m *InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAudio
+	return json.Marshal(&struct {
+		Type InputMediaType `json:"type"`
+		*alias
+	}{InputMediaTypeAudio, (*alias)(m)})
+}
+
+// InputMediaDocument is a general file to be sent.
+type InputMediaDocument struct {
+	// Media is a file to send.
+	Media *InputFile `json:"media"`
+
+	// Thumb is a thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file.
+	//
+	// Optional.
+	Thumb *InputFile `json:"thumb,omitempty"`
+
+	// Caption of the document to be sent, 0-1024 characters after entities parsing.
+	//
+	// Optional.
+	Caption string `json:"caption,omitempty"`
+
+	// ParseMode is a mode for parsing entities in the document caption.
+	//
+	// Optional.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+
+	// CaptionEntities is a list of special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// Optional.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+
+	// DisableContentTypeDetection disables automatic server-side content type detection for files uploaded using multipart/form-data.
+	// Always True, if the document is sent as part of an album.
+	//
+	// Optional.
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
+
+func (m *InputMediaDocument) inputMediaType() InputMediaType { return InputMediaTypeDocument }
+
+func (m *InputMediaDocument) inputFiles() []*InputFile { return []*InputFile{m.Media, m.Thumb} }
+
+// MarshalJSON encodes the document along with its type.
+func (m *InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type alias InputMediaDocument
+	return json.Marshal(&struct {
+		Type InputMediaType `json:"type"`
+		*alias
+	}{InputMediaTypeDocument, (*alias)(m)})
+}
+
+// InputFile is the contents of a file to be sent.
+// It is either a file that already exists on the Telegram servers or in the Internet,
+// or a new file to be uploaded using multipart/form-data.
+// See InputFileID, InputFileURL and InputFileReader.
+//
+// An InputFile with a Reader must not be used by several requests at the same time.
+type InputFile struct {
+	// ID is a file_id of a file that exists on the Telegram servers,
+	// or an HTTP URL for Telegram to get a file from the Internet.
+	ID string
+
+	// Name of a file to be uploaded.
+	Name string
+
+	// Reader is the contents of a file to be uploaded.
+	Reader io.Reader
+
+	// attach is a name of the multipart/form-data part with the uploaded file.
+	attach string
+}
+
+// InputFileID returns an InputFile for a file that exists on the Telegram servers.
+func InputFileID(fileID string) *InputFile {
+	return &InputFile{ID: fileID}
+}
+
+// InputFileURL returns an InputFile for Telegram to get from the Internet.
+func InputFileURL(url string) *InputFile {
+	return &InputFile{ID: url}
+}
+
+// InputFileReader returns an InputFile to be uploaded from r.
+func InputFileReader(name string, r io.Reader) *InputFile {
+	return &InputFile{Name: name, Reader: r}
+}
+
+// MarshalJSON encodes the file as a file_id, an HTTP URL or an attach://<file_attach_name> reference.
+func (f *InputFile) MarshalJSON() ([]byte, error) {
+	if f.Reader != nil {
+		return json.Marshal("attach://" + f.attach)
+	}
+
+	return json.Marshal(f.ID)
+}
+
 // Sticker is a sticker.
 type Sticker struct {
 	// FileID is an identifier for this file, which can be used to download or reuse the file.
@@ -2643,7 +3105,7 @@ type ShippingOption struct {
 	Title string `json:"title"`
 
 	// Prices is an array of price portions.
-	Prices []*LabeledPrice
+	Prices []*LabeledPrice `json:"prices"`
 }
 
 // SuccessfulPayment contains basic information about a successful payment.
@@ -2875,6 +3337,199 @@ type EncryptedCredentials struct {
 	Secret string `json:"secret"`
 }
 
+// Credentials is the decrypted EncryptedCredentials, containing the secrets required
+// to decrypt and authenticate the data and files of EncryptedPassportElement.
+type Credentials struct {
+	// SecureData are credentials for encrypted data.
+	SecureData *SecureData `json:"secure_data"`
+
+	// Nonce is the bot-specified nonce, which should be checked to match the requested one.
+	Nonce string `json:"nonce"`
+}
+
+// SecureData are credentials for the encrypted data of every element type.
+type SecureData struct {
+	// PersonalDetails are credentials for encrypted personal details.
+	//
+	// Optional.
+	PersonalDetails *SecureValue `json:"personal_details,omitempty"`
+
+	// Passport are credentials for encrypted passport.
+	//
+	// Optional.
+	Passport *SecureValue `json:"passport,omitempty"`
+
+	// InternalPassport are credentials for encrypted internal passport.
+	//
+	// Optional.
+	InternalPassport *SecureValue `json:"internal_passport,omitempty"`
+
+	// DriverLicense are credentials for encrypted driver license.
+	//
+	// Optional.
+	DriverLicense *SecureValue `json:"driver_license,omitempty"`
+
+	// IdentityCard are credentials for encrypted ID card.
+	//
+	// Optional.
+	IdentityCard *SecureValue `json:"identity_card,omitempty"`
+
+	// Address are credentials for encrypted residential address.
+	//
+	// Optional.
+	Address *SecureValue `json:"address,omitempty"`
+
+	// UtilityBill are credentials for encrypted utility bill.
+	//
+	// Optional.
+	UtilityBill *SecureValue `json:"utility_bill,omitempty"`
+
+	// BankStatement are credentials for encrypted bank statement.
+	//
+	// Optional.
+	BankStatement *SecureValue `json:"bank_statement,omitempty"`
+
+	// RentalAgreement are credentials for encrypted rental agreement.
+	//
+	// Optional.
+	RentalAgreement *SecureValue `json:"rental_agreement,omitempty"`
+
+	// PassportRegistration are credentials for encrypted registration from internal passport.
+	//
+	// Optional.
+	PassportRegistration *SecureValue `json:"passport_registration,omitempty"`
+
+	// TemporaryRegistration are credentials for encrypted temporary registration.
+	//
+	// Optional.
+	TemporaryRegistration *SecureValue `json:"temporary_registration,omitempty"`
+}
+
+// SecureValue are credentials required to decrypt and authenticate the data and files of an element.
+type SecureValue struct {
+	// Data are credentials for encrypted Telegram Passport data.
+	//
+	// Optional.
+	Data *DataCredentials `json:"data,omitempty"`
+
+	// FrontSide are credentials for an encrypted document's front side.
+	//
+	// Optional.
+	FrontSide *FileCredentials `json:"front_side,omitempty"`
+
+	// ReverseSide are credentials for an encrypted document's reverse side.
+	//
+	// Optional.
+	ReverseSide *FileCredentials `json:"reverse_side,omitempty"`
+
+	// Selfie are credentials for an encrypted selfie of the user with a document.
+	//
+	// Optional.
+	Selfie *FileCredentials `json:"selfie,omitempty"`
+
+	// Translation are credentials for an encrypted translation of the document.
+	//
+	// Optional.
+	Translation []*FileCredentials `json:"translation,omitempty"`
+
+	// Files are credentials for encrypted files.
+	//
+	// Optional.
+	Files []*FileCredentials `json:"files,omitempty"`
+}
+
+// DataCredentials can be used to decrypt encrypted data from the data field in EncryptedPassportElement.
+type DataCredentials struct {
+	// DataHash is a checksum of encrypted data.
+	DataHash string `json:"data_hash"`
+
+	// Secret of encrypted data.
+	Secret string `json:"secret"`
+}
+
+// FileCredentials can be used to decrypt encrypted files from the front_side, reverse_side, selfie,
+// files and translation fields in EncryptedPassportElement.
+type FileCredentials struct {
+	// FileHash is a checksum of encrypted file.
+	FileHash string `json:"file_hash"`
+
+	// Secret of encrypted file.
+	Secret string `json:"secret"`
+}
+
+// PersonalDetails represents personal details.
+type PersonalDetails struct {
+	// FirstName is a first name.
+	FirstName string `json:"first_name"`
+
+	// LastName is a last name.
+	LastName string `json:"last_name"`
+
+	// MiddleName is a middle name.
+	//
+	// Optional.
+	MiddleName string `json:"middle_name,omitempty"`
+
+	// BirthDate is a date of birth in DD.MM.YYYY format.
+	BirthDate string `json:"birth_date"`
+
+	// Gender, male or female.
+	Gender string `json:"gender"`
+
+	// CountryCode is a citizenship (ISO 3166-1 alpha-2 country code).
+	CountryCode string `json:"country_code"`
+
+	// ResidenceCountryCode is a country of residence (ISO 3166-1 alpha-2 country code).
+	ResidenceCountryCode string `json:"residence_country_code"`
+
+	// FirstNameNative is a first name in the language of the user's country of residence.
+	FirstNameNative string `json:"first_name_native"`
+
+	// LastNameNative is a last name in the language of the user's country of residence.
+	LastNameNative string `json:"last_name_native"`
+
+	// MiddleNameNative is a middle name in the language of the user's country of residence.
+	//
+	// Optional.
+	MiddleNameNative string `json:"middle_name_native,omitempty"`
+}
+
+// ResidentialAddress represents a residential address.
+type ResidentialAddress struct {
+	// StreetLine1 is a first line for the address.
+	StreetLine1 string `json:"street_line1"`
+
+	// StreetLine2 is a second line for the address.
+	//
+	// Optional.
+	StreetLine2 string `json:"street_line2,omitempty"`
+
+	// City.
+	City string `json:"city"`
+
+	// State.
+	//
+	// Optional.
+	State string `json:"state,omitempty"`
+
+	// CountryCode is an ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"country_code"`
+
+	// PostCode is an address post code.
+	PostCode string `json:"post_code"`
+}
+
+// IdDocumentData represents the data of an identity document.
+type IdDocumentData struct {
+	// DocumentNo is a document number.
+	DocumentNo string `json:"document_no"`
+
+	// ExpiryDate is a date of expiry, in DD.MM.YYYY format.
+	//
+	// Optional.
+	ExpiryDate string `json:"expiry_date,omitempty"`
+}
+
 type PassportElementErrorSource string
 
 const (
@@ -2890,26 +3545,19 @@ const (
 )
 
 // PassportElementError is an error in the Telegram Passport element which was submitted that should be resolved by the user.
-// It should be one of:
-// PassportElementErrorDataField,
-// PassportElementErrorFrontSide,
-// PassportElementErrorReverseSide,
-// PassportElementErrorSelfie,
-// PassportElementErrorFile,
-// PassportElementErrorFiles,
-// PassportElementErrorTranslationFile,
-// PassportElementErrorTranslationFiles,
-// PassportElementErrorUnspecified.
-type PassportElementError struct {
-	PassportElementErrorDataField
-	PassportElementErrorFrontSide
-	PassportElementErrorReverseSide
-	PassportElementErrorSelfie
-	PassportElementErrorFile
-	PassportElementErrorFiles
-	PassportElementErrorTranslationFile
-	PassportElementErrorTranslationFiles
-	PassportElementErrorUnspecified
+// Currently, the following 9 types of errors are supported:
+// *PassportElementErrorDataField,
+// *PassportElementErrorFrontSide,
+// *PassportElementErrorReverseSide,
+// *PassportElementErrorSelfie,
+// *PassportElementErrorFile,
+// *PassportElementErrorFiles,
+// *PassportElementErrorTranslationFile,
+// *PassportElementErrorTranslationFiles,
+// *PassportElementErrorUnspecified.
+type PassportElementError interface {
+	// passportElementError restricts implementations to the types of this package.
+	passportElementError()
 }
 
 // PassportElementErrorDataField is an issue in one of the data fields that was provided by the user.
@@ -3103,6 +3751,16 @@ type PassportElementErrorUnspecified struct {
 	Message string `json:"message"`
 }
 
+func (*PassportElementErrorDataField) passportElementError()        {}
+func (*PassportElementErrorFrontSide) passportElementError()        {}
+func (*PassportElementErrorReverseSide) passportElementError()      {}
+func (*PassportElementErrorSelfie) passportElementError()           {}
+func (*PassportElementErrorFile) passportElementError()             {}
+func (*PassportElementErrorFiles) passportElementError()            {}
+func (*PassportElementErrorTranslationFile) passportElementError()  {}
+func (*PassportElementErrorTranslationFiles) passportElementError() {}
+func (*PassportElementErrorUnspecified) passportElementError()      {}
+
 // Game is a Telegram game.
 type Game struct {
 	// Title of the game.
@@ -0,0 +1,252 @@
+package telegram
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebAppInitData is the data transferred to a Web App when it's opened.
+// https://core.telegram.org/bots/webapps#webappinitdata
+type WebAppInitData struct {
+	// QueryID is a unique identifier for the Web App session, required for sending messages via AnswerWebAppQuery.
+	//
+	// Optional.
+	QueryID string
+
+	// User is an information about the current user.
+	//
+	// Optional.
+	User *WebAppUser
+
+	// Receiver is an information about the chat partner of the current user in the chat
+	// where the bot was launched via the attachment menu.
+	//
+	// Optional.
+	Receiver *WebAppUser
+
+	// Chat is an information about the chat where the bot was launched via the attachment menu.
+	//
+	// Optional.
+	Chat *WebAppChat
+
+	// ChatType is a type of the chat from which the Web App was opened.
+	//
+	// Optional.
+	ChatType string
+
+	// ChatInstance is a global identifier, uniquely corresponding to the chat from which the Web App was opened.
+	//
+	// Optional.
+	ChatInstance string
+
+	// StartParam is the value of the startattach or startapp parameter passed via link.
+	//
+	// Optional.
+	StartParam string
+
+	// CanSendAfter is a time after which a message can be sent via AnswerWebAppQuery.
+	//
+	// Optional.
+	CanSendAfter time.Duration
+
+	// AuthDate is the time when the form was opened.
+	AuthDate time.Time
+
+	// Hash of all passed parameters, which the bot server can use to check their validity.
+	Hash string
+}
+
+// WebAppUser is a user of a Web App.
+type WebAppUser struct {
+	// ID is a unique identifier for this user or bot.
+	ID int64 `json:"id"`
+
+	// IsBot is True, if this user is a bot.
+	//
+	// Optional.
+	IsBot bool `json:"is_bot,omitempty"`
+
+	// FirstName is a first name of the user or bot.
+	FirstName string `json:"first_name"`
+
+	// LastName is a last name of the user or bot.
+	//
+	// Optional.
+	LastName string `json:"last_name,omitempty"`
+
+	// Username is a username of the user or bot.
+	//
+	// Optional.
+	Username string `json:"username,omitempty"`
+
+	// LanguageCode is an IETF language tag of the user's language.
+	//
+	// Optional.
+	LanguageCode string `json:"language_code,omitempty"`
+
+	// IsPremium is True, if this user is a Telegram Premium user.
+	//
+	// Optional.
+	IsPremium bool `json:"is_premium,omitempty"`
+
+	// AllowsWriteToPM is True, if this user allowed the bot to message them.
+	//
+	// Optional.
+	AllowsWriteToPM bool `json:"allows_write_to_pm,omitempty"`
+
+	// PhotoURL is a URL of the user's profile photo.
+	//
+	// Optional.
+	PhotoURL string `json:"photo_url,omitempty"`
+}
+
+// WebAppChat is a chat of a Web App.
+type WebAppChat struct {
+	// ID is a unique identifier for this chat.
+	ID int64 `json:"id"`
+
+	// Type of chat, can be either "group", "supergroup" or "channel".
+	Type string `json:"type"`
+
+	// Title of the chat.
+	Title string `json:"title"`
+
+	// Username of the chat.
+	//
+	// Optional.
+	Username string `json:"username,omitempty"`
+
+	// PhotoURL is a URL of the chat's photo.
+	//
+	// Optional.
+	PhotoURL string `json:"photo_url,omitempty"`
+}
+
+// ValidateWebAppInitData checks the init data of a Web App is signed with the bot token and parses it.
+// If maxAge is not 0, the data older than maxAge is rejected.
+func ValidateWebAppInitData(token, initData string, maxAge time.Duration) (*WebAppInitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("web app init data: %w", err)
+	}
+
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(token))
+
+	if err := checkDataHash(values, secret.Sum(nil)); err != nil {
+		return nil, fmt.Errorf("web app init data: %w", err)
+	}
+
+	data := &WebAppInitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		Hash:         values.Get("hash"),
+	}
+
+	if data.AuthDate, err = parseAuthDate(values.Get("auth_date"), maxAge); err != nil {
+		return nil, fmt.Errorf("web app init data: %w", err)
+	}
+
+	if v := values.Get("can_send_after"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("web app init data: can_send_after: %w", err)
+		}
+		data.CanSendAfter = time.Duration(seconds) * time.Second
+	}
+
+	for key, dst := range map[string]interface{}{"user": &data.User, "receiver": &data.Receiver, "chat": &data.Chat} {
+		if v := values.Get(key); v != "" {
+			if err := json.Unmarshal([]byte(v), dst); err != nil {
+				return nil, fmt.Errorf("web app init data: %s: %w", key, err)
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// checkDataHash checks the hash value is the hex HMAC-SHA256 of the other values signed with the key.
+// The values are signed as "key=value" lines sorted by key.
+func checkDataHash(values url.Values, key []byte) error {
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return fmt.Errorf("hash is missing or malformed")
+	}
+
+	lines := make([]string, 0, len(values))
+	for k := range values {
+		if k != "hash" {
+			lines = append(lines, k+"="+values.Get(k))
+		}
+	}
+	sort.Strings(lines)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join(lines, "\n")))
+
+	if !hmac.Equal(hash, mac.Sum(nil)) {
+		return fmt.Errorf("hash mismatch")
+	}
+
+	return nil
+}
+
+// parseAuthDate parses the Unix time of the authorization, rejecting it if it's older than maxAge.
+func parseAuthDate(v string, maxAge time.Duration) (time.Time, error) {
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("auth_date: %w", err)
+	}
+
+	authDate := time.Unix(seconds, 0)
+	if maxAge > 0 && time.Since(authDate) > maxAge {
+		return time.Time{}, fmt.Errorf("auth_date %s is older than %s", authDate.UTC().Format(time.RFC3339), maxAge)
+	}
+
+	return authDate, nil
+}
+
+// webAppInitDataKey is the context key of WebAppInitData.
+type webAppInitDataKey struct{}
+
+// WebAppMiddleware authorizes requests of a Web App carrying its init data in the "Authorization: tma <initData>" header.
+// Unauthorized requests are rejected with 401 Unauthorized,
+// while the authorized ones reach the next handler with WebAppInitData in their context.
+func WebAppMiddleware(token string, maxAge time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			initData := strings.TrimPrefix(r.Header.Get("Authorization"), "tma ")
+			if initData == r.Header.Get("Authorization") {
+				http.Error(w, "missing web app init data", http.StatusUnauthorized)
+				return
+			}
+
+			data, err := ValidateWebAppInitData(token, initData, maxAge)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), webAppInitDataKey{}, data)))
+		})
+	}
+}
+
+// WebAppInitDataFromContext returns the WebAppInitData stored in the context by WebAppMiddleware.
+func WebAppInitDataFromContext(ctx context.Context) (*WebAppInitData, bool) {
+	data, ok := ctx.Value(webAppInitDataKey{}).(*WebAppInitData)
+	return data, ok
+}